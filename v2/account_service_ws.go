@@ -0,0 +1,202 @@
+package binance
+
+import (
+	"context"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// AccountStatusWsService queries account information
+type AccountStatusWsService struct {
+	c *WsApiClient
+}
+
+// AccountStatusWsRequest parameters for 'account.status' websocket API
+type AccountStatusWsRequest struct {
+	omitZeroBalances *bool
+	recvWindow       *uint16
+}
+
+// NewAccountStatusWsRequest init AccountStatusWsRequest
+func NewAccountStatusWsRequest() *AccountStatusWsRequest {
+	return &AccountStatusWsRequest{}
+}
+
+// OmitZeroBalances set omitZeroBalances
+func (s *AccountStatusWsRequest) OmitZeroBalances(omitZeroBalances bool) *AccountStatusWsRequest {
+	s.omitZeroBalances = &omitZeroBalances
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *AccountStatusWsRequest) RecvWindow(recvWindow uint16) *AccountStatusWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// buildParams builds params
+func (s *AccountStatusWsRequest) buildParams() params {
+	m := params{}
+	if s.omitZeroBalances != nil {
+		m["omitZeroBalances"] = *s.omitZeroBalances
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'account.status' request
+func (s *AccountStatusWsService) Do(requestID string, request *AccountStatusWsRequest) error {
+	return s.c.write(requestID, websocket.AccountStatusSpotWsApiMethod, request.buildParams(), true)
+}
+
+// SyncDo - sends 'account.status' request and receives response
+func (s *AccountStatusWsService) SyncDo(requestID string, request *AccountStatusWsRequest) (*AccountStatusWsResponse, error) {
+	return s.syncDo(s.c.writeSync, requestID, request)
+}
+
+// SyncDoContext - sends 'account.status' request and receives response until ctx is done
+func (s *AccountStatusWsService) SyncDoContext(ctx context.Context, requestID string, request *AccountStatusWsRequest) (*AccountStatusWsResponse, error) {
+	return s.syncDo(s.c.writeSyncContext(ctx), requestID, request)
+}
+
+func (s *AccountStatusWsService) syncDo(writeSync wsApiWriteSync, requestID string, request *AccountStatusWsRequest) (*AccountStatusWsResponse, error) {
+	res := &AccountStatusWsResponse{}
+	if err := writeSync(requestID, websocket.AccountStatusSpotWsApiMethod, request.buildParams(), true, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AccountStatusWsResponse define 'account.status' websocket API response
+type AccountStatusWsResponse struct {
+	Id     string  `json:"id"`
+	Status int     `json:"status"`
+	Result Account `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// MyTradesWsService queries the account trade list of a symbol
+type MyTradesWsService struct {
+	c *WsApiClient
+}
+
+// MyTradesWsRequest parameters for 'myTrades' websocket API
+type MyTradesWsRequest struct {
+	symbol     string
+	orderID    *int64
+	startTime  *int64
+	endTime    *int64
+	fromID     *int64
+	limit      *int
+	recvWindow *uint16
+}
+
+// NewMyTradesWsRequest init MyTradesWsRequest
+func NewMyTradesWsRequest() *MyTradesWsRequest {
+	return &MyTradesWsRequest{}
+}
+
+// Symbol set symbol
+func (s *MyTradesWsRequest) Symbol(symbol string) *MyTradesWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *MyTradesWsRequest) OrderID(orderID int64) *MyTradesWsRequest {
+	s.orderID = &orderID
+	return s
+}
+
+// StartTime set startTime
+func (s *MyTradesWsRequest) StartTime(startTime int64) *MyTradesWsRequest {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *MyTradesWsRequest) EndTime(endTime int64) *MyTradesWsRequest {
+	s.endTime = &endTime
+	return s
+}
+
+// FromID set fromID
+func (s *MyTradesWsRequest) FromID(fromID int64) *MyTradesWsRequest {
+	s.fromID = &fromID
+	return s
+}
+
+// Limit set limit
+func (s *MyTradesWsRequest) Limit(limit int) *MyTradesWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *MyTradesWsRequest) RecvWindow(recvWindow uint16) *MyTradesWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// buildParams builds params
+func (s *MyTradesWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	if s.fromID != nil {
+		m["fromId"] = *s.fromID
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'myTrades' request
+func (s *MyTradesWsService) Do(requestID string, request *MyTradesWsRequest) error {
+	return s.c.write(requestID, websocket.MyTradesSpotWsApiMethod, request.buildParams(), true)
+}
+
+// SyncDo - sends 'myTrades' request and receives response
+func (s *MyTradesWsService) SyncDo(requestID string, request *MyTradesWsRequest) (*MyTradesWsResponse, error) {
+	return s.syncDo(s.c.writeSync, requestID, request)
+}
+
+// SyncDoContext - sends 'myTrades' request and receives response until ctx is done
+func (s *MyTradesWsService) SyncDoContext(ctx context.Context, requestID string, request *MyTradesWsRequest) (*MyTradesWsResponse, error) {
+	return s.syncDo(s.c.writeSyncContext(ctx), requestID, request)
+}
+
+func (s *MyTradesWsService) syncDo(writeSync wsApiWriteSync, requestID string, request *MyTradesWsRequest) (*MyTradesWsResponse, error) {
+	res := &MyTradesWsResponse{}
+	if err := writeSync(requestID, websocket.MyTradesSpotWsApiMethod, request.buildParams(), true, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MyTradesWsResponse define 'myTrades' websocket API response
+type MyTradesWsResponse struct {
+	Id     string     `json:"id"`
+	Status int        `json:"status"`
+	Result []*TradeV3 `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
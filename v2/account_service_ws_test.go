@@ -0,0 +1,105 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/stretchr/testify/suite"
+)
+
+type accountServiceWsTestSuite struct {
+	baseWsApiTestSuite
+}
+
+func TestAccountServiceWs(t *testing.T) {
+	suite.Run(t, new(accountServiceWsTestSuite))
+}
+
+func (s *accountServiceWsTestSuite) TestAccountStatus() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 200,
+		"result": {
+			"makerCommission": 15,
+			"takerCommission": 15,
+			"buyerCommission": 0,
+			"sellerCommission": 0,
+			"canTrade": true,
+			"canWithdraw": true,
+			"canDeposit": true,
+			"updateTime": 1660587394648,
+			"accountType": "SPOT",
+			"balances": [
+				{"asset": "BNB", "free": "0.00000000", "locked": "0.00000000"},
+				{"asset": "BTC", "free": "1.3447112", "locked": "0.08600000"}
+			],
+			"permissions": ["SPOT"],
+			"uid": 354937868
+		}
+	}`)
+
+	res, err := s.wsClient.NewAccountStatusWsService().SyncDo(s.requestID, NewAccountStatusWsRequest().
+		OmitZeroBalances(true))
+	s.Require().NoError(err)
+	s.Equal(websocket.AccountStatusSpotWsApiMethod, req.Method)
+	s.Equal(true, req.Params["omitZeroBalances"])
+	s.assertSigned(req)
+
+	s.Equal(int64(15), res.Result.MakerCommission)
+	s.Equal("SPOT", res.Result.AccountType)
+	s.Require().Len(res.Result.Balances, 2)
+	s.Equal(Balance{Asset: "BTC", Free: "1.3447112", Locked: "0.08600000"}, res.Result.Balances[1])
+	s.Equal(int64(354937868), res.Result.UID)
+}
+
+func (s *accountServiceWsTestSuite) TestMyTrades() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 200,
+		"result": [
+			{
+				"symbol": "BTCUSDT",
+				"id": 1650422481,
+				"orderId": 12569099453,
+				"orderListId": -1,
+				"price": "23416.10000000",
+				"qty": "0.00635000",
+				"quoteQty": "148.69223500",
+				"commission": "0.00000000",
+				"commissionAsset": "BNB",
+				"time": 1660801715793,
+				"isBuyer": false,
+				"isMaker": true,
+				"isBestMatch": true
+			}
+		]
+	}`)
+
+	res, err := s.wsClient.NewMyTradesWsService().SyncDo(s.requestID, NewMyTradesWsRequest().
+		Symbol("BTCUSDT").FromID(1650422481).Limit(10))
+	s.Require().NoError(err)
+	s.Equal(websocket.MyTradesSpotWsApiMethod, req.Method)
+	s.Equal("BTCUSDT", req.Params["symbol"])
+	s.Equal(float64(1650422481), req.Params["fromId"])
+	s.Equal(float64(10), req.Params["limit"])
+	s.assertSigned(req)
+
+	s.Require().Len(res.Result, 1)
+	s.Equal(&TradeV3{
+		ID:              1650422481,
+		Symbol:          "BTCUSDT",
+		OrderID:         12569099453,
+		OrderListId:     -1,
+		Price:           "23416.10000000",
+		Quantity:        "0.00635000",
+		QuoteQuantity:   "148.69223500",
+		Commission:      "0.00000000",
+		CommissionAsset: "BNB",
+		Time:            1660801715793,
+		IsBuyer:         false,
+		IsMaker:         true,
+		IsBestMatch:     true,
+	}, res.Result[0])
+}
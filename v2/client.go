@@ -134,6 +134,27 @@ type SelfTradePreventionMode string
 
 type MarginAccountBorrowRepayType string
 
+// CancelReplaceModeType define cancel replace mode
+type CancelReplaceModeType string
+
+// CancelRestrictionsType define the order status required for the cancel to succeed
+type CancelRestrictionsType string
+
+// OrderRateLimitExceededModeType define the behavior of cancel replace when the unfilled order count is exceeded
+type OrderRateLimitExceededModeType string
+
+// CancelReplaceResultType define the result of each step of a cancel replace
+type CancelReplaceResultType string
+
+// ContingencyType define the type of an order list
+type ContingencyType string
+
+// ListStatusType define the status of an order list
+type ListStatusType string
+
+// ListOrderStatusType define the status of the orders of an order list
+type ListOrderStatusType string
+
 // UseTestnet switch all the API endpoints from production to the testnet
 var UseTestnet = false
 
@@ -159,6 +180,7 @@ const (
 	NewOrderRespTypeFULL   NewOrderRespType = "FULL"
 
 	OrderStatusTypeNew             OrderStatusType = "NEW"
+	OrderStatusTypePendingNew      OrderStatusType = "PENDING_NEW" // pending order of an order list
 	OrderStatusTypePartiallyFilled OrderStatusType = "PARTIALLY_FILLED"
 	OrderStatusTypeFilled          OrderStatusType = "FILLED"
 	OrderStatusTypeCanceled        OrderStatusType = "CANCELED"
@@ -191,7 +213,8 @@ const (
 	UserDataEventTypeOutboundAccountPosition UserDataEventType = "outboundAccountPosition"
 	UserDataEventTypeBalanceUpdate           UserDataEventType = "balanceUpdate"
 	UserDataEventTypeExecutionReport         UserDataEventType = "executionReport"
-	UserDataEventTypeListStatus              UserDataEventType = "ListStatus"
+	UserDataEventTypeListStatus              UserDataEventType = "listStatus"
+	UserDataEventTypeListenKeyExpired        UserDataEventType = "listenKeyExpired"
 
 	MarginTransferTypeToMargin MarginTransferType = 1
 	MarginTransferTypeToMain   MarginTransferType = 2
@@ -332,6 +355,32 @@ const (
 	MarginAccountBorrowRepayStatusPending   string = "PENDING"
 	MarginAccountBorrowRepayStatusConfirmed string = "CONFIRMED"
 	MarginAccountBorrowRepayStatusFailed    string = "FAILED"
+
+	CancelReplaceModeTypeStopOnFailure CancelReplaceModeType = "STOP_ON_FAILURE"
+	CancelReplaceModeTypeAllowFailure  CancelReplaceModeType = "ALLOW_FAILURE"
+
+	CancelRestrictionsTypeOnlyNew             CancelRestrictionsType = "ONLY_NEW"
+	CancelRestrictionsTypeOnlyPartiallyFilled CancelRestrictionsType = "ONLY_PARTIALLY_FILLED"
+
+	OrderRateLimitExceededModeTypeDoNothing  OrderRateLimitExceededModeType = "DO_NOTHING"
+	OrderRateLimitExceededModeTypeCancelOnly OrderRateLimitExceededModeType = "CANCEL_ONLY"
+
+	CancelReplaceResultTypeSuccess      CancelReplaceResultType = "SUCCESS"
+	CancelReplaceResultTypeFailure      CancelReplaceResultType = "FAILURE"
+	CancelReplaceResultTypeNotAttempted CancelReplaceResultType = "NOT_ATTEMPTED"
+
+	// ContingencyTypeOTO is used by both OTO and OTOCO order lists
+	ContingencyTypeOCO ContingencyType = "OCO"
+	ContingencyTypeOTO ContingencyType = "OTO"
+
+	ListStatusTypeResponse    ListStatusType = "RESPONSE"
+	ListStatusTypeExecStarted ListStatusType = "EXEC_STARTED"
+	ListStatusTypeUpdated     ListStatusType = "UPDATED"
+	ListStatusTypeAllDone     ListStatusType = "ALL_DONE"
+
+	ListOrderStatusTypeExecuting ListOrderStatusType = "EXECUTING"
+	ListOrderStatusTypeAllDone   ListOrderStatusType = "ALL_DONE"
+	ListOrderStatusTypeReject    ListOrderStatusType = "REJECT"
 )
 
 func currentTimestamp() int64 {
@@ -371,6 +420,7 @@ func NewClient(apiKey, secretKey string) *Client {
 		UserAgent:  "Binance/golang",
 		HTTPClient: http.DefaultClient,
 		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		UsedLimits: common.NewUsedLimits(),
 	}
 }
 
@@ -393,7 +443,8 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 		HTTPClient: &http.Client{
 			Transport: tr,
 		},
-		Logger: log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		UsedLimits: common.NewUsedLimits(),
 	}
 }
 
@@ -427,6 +478,20 @@ type Client struct {
 	TimeOffset int64
 	do         doFunc
 
+	// RateLimiter is an optional client side rate limiter, see NewRateLimiter
+	RateLimiter *common.RateLimiter
+	// RetryPolicy enables retries of failed requests, see common.NewRetryPolicy
+	RetryPolicy *common.RetryPolicy
+	// ClockSync keeps the timestamp of signed requests in sync with the server, see NewClockSync
+	ClockSync *common.ClockSync
+	// Interceptors are called in order around each request, see common.Interceptor
+	Interceptors []common.Interceptor
+	// StructuredLogger receives the logs of the client, the secrets are redacted.
+	// Logger is used at debug level when it is nil and Debug is set.
+	StructuredLogger common.Logger
+	// UsedLimits keeps the used weight and order count reported by the server, see common.UsedLimits
+	UsedLimits *common.UsedLimits
+
 	UsedWeight UsedWeight
 	OrderCount OrderCount
 }
@@ -441,10 +506,23 @@ type OrderCount struct {
 	Count1d  int64
 }
 
-func (c *Client) debug(format string, v ...interface{}) {
+// logger return StructuredLogger, or Logger at debug level if Debug is set
+func (c *Client) logger() common.Logger {
+	if c.StructuredLogger != nil {
+		return c.StructuredLogger
+	}
 	if c.Debug {
-		c.Logger.Printf(format, v...)
+		return common.NewStdLogger(c.Logger, common.LogLevelDebug)
 	}
+	return common.NopLogger
+}
+
+// timeOffset return the offset of ClockSync once synced, TimeOffset otherwise
+func (c *Client) timeOffset() int64 {
+	if offset, ok := c.ClockSync.Offset(); ok {
+		return offset
+	}
+	return c.TimeOffset
 }
 
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
@@ -462,7 +540,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.timeOffset())
 	}
 	queryString := r.query.Encode()
 	// @ is a safe character and does not require escape, So replace it back.
@@ -507,7 +585,8 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if queryString != "" {
 		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
 	}
-	c.debug("full url: %s, body: %s\n", fullURL, bodyString)
+	c.logger().Debug("request", "method", r.method, "url", common.RedactURL(fullURL),
+		"body", common.RedactQuery(bodyString), "header", common.RedactHeader(header))
 
 	r.fullURL = fullURL
 	r.header = header
@@ -516,25 +595,67 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	resynced := false
+	for attempt := 0; ; attempt++ {
+		var res *http.Response
+		data, res, err = c.callAPIOnce(ctx, r, attempt, opts...)
+		// re-issue a request rejected for its timestamp once, after resyncing the clock
+		if !resynced && r.secType == secTypeSigned && c.ClockSync.Resync(ctx, err) {
+			resynced = true
+			opts = nil
+			continue
+		}
+		delay, retry := c.RetryPolicy.Retry(attempt, r.method, res, err)
+		if !retry {
+			return data, err
+		}
+		c.logger().Warn("retry request", "endpoint", r.endpoint, "attempt", attempt, "delay", delay, "error", err)
+		if err = common.Sleep(ctx, delay); err != nil {
+			return []byte{}, err
+		}
+		// request options have been applied by the first attempt
+		opts = nil
+	}
+}
+
+func (c *Client) callAPIOnce(ctx context.Context, r *request, attempt int, opts ...RequestOption) (data []byte, res *http.Response, err error) {
 	err = c.parseRequest(r, opts...)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, nil, err
 	}
 	req, err := http.NewRequest(r.method, r.fullURL, r.body)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, nil, err
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
-	c.debug("request: %#v\n", req)
+	weight, orders := requestCost(r)
+	if c.RateLimiter != nil {
+		if err = c.RateLimiter.Wait(ctx, weight, orders); err != nil {
+			return []byte{}, nil, err
+		}
+	}
+	call := common.NewCall(r.method, r.endpoint, r.query, r.form, weight, orders, attempt)
+	err = common.Invoke(ctx, c.Interceptors, call, func(ctx context.Context) (*http.Response, error) {
+		var sendErr error
+		data, res, sendErr = c.send(req.WithContext(ctx))
+		return res, sendErr
+	})
+	return data, res, err
+}
+
+// send sends the request and reads the response, an API error is returned for 4xx and 5xx responses
+func (c *Client) send(req *http.Request) (data []byte, res *http.Response, err error) {
 	f := c.do
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
-	res, err := f(req)
+	res, err = f(req)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, nil, err
 	}
+	c.RateLimiter.Update(res.Header)
+	c.UsedLimits.Update(res.Header)
 
 	usedWeight := res.Header.Get("X-Mbx-Used-Weight")
 	if usedWeight != "" {
@@ -565,7 +686,7 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 
 	data, err = io.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, res, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -575,22 +696,21 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 			err = cerr
 		}
 	}()
-	c.debug("response: %#v\n", res)
-	c.debug("response body: %s\n", string(data))
-	c.debug("response status code: %d\n", res.StatusCode)
+	c.logger().Debug("response", "endpoint", req.URL.Path, "status", res.StatusCode, "body", common.RedactedJSON(data))
 
 	if res.StatusCode >= http.StatusBadRequest {
 		apiErr := new(common.APIError)
 		e := json.Unmarshal(data, apiErr)
 		if e != nil {
-			c.debug("failed to unmarshal json: %s\n", e)
+			c.logger().Warn("failed to unmarshal api error", "error", e)
 		}
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, apiErr
+		// the body is returned along with the error, some errors carry data, e.g. a partially failed cancel replace
+		return data, res, apiErr
 	}
-	return data, nil
+	return data, res, nil
 }
 
 // SetApiEndpoint set api Endpoint
@@ -614,6 +734,14 @@ func (c *Client) NewSetServerTimeService() *SetServerTimeService {
 	return &SetServerTimeService{c: c}
 }
 
+// NewClockSync init a ClockSync polling the server time, assign it to Client.ClockSync
+// and call Start to keep the timestamp of signed requests in sync in the background
+func (c *Client) NewClockSync() *common.ClockSync {
+	return common.NewClockSync(func(ctx context.Context) (int64, error) {
+		return c.NewServerTimeService().Do(ctx)
+	})
+}
+
 // NewDepthService init depth service
 func (c *Client) NewDepthService() *DepthService {
 	return &DepthService{c: c}
@@ -672,6 +800,26 @@ func (c *Client) NewCreateOCOService() *CreateOCOService {
 	return &CreateOCOService{c: c}
 }
 
+// NewCreateOTOService init creating OTO service
+func (c *Client) NewCreateOTOService() *CreateOTOService {
+	return &CreateOTOService{c: c}
+}
+
+// NewCreateOTOCOService init creating OTOCO service
+func (c *Client) NewCreateOTOCOService() *CreateOTOCOService {
+	return &CreateOTOCOService{c: c}
+}
+
+// NewCreateSOROrderService init creating SOR order service
+func (c *Client) NewCreateSOROrderService() *CreateSOROrderService {
+	return &CreateSOROrderService{c: c}
+}
+
+// NewListAllocationsService init list allocations service
+func (c *Client) NewListAllocationsService() *ListAllocationsService {
+	return &ListAllocationsService{c: c}
+}
+
 // NewCancelOCOService init cancel OCO service
 func (c *Client) NewCancelOCOService() *CancelOCOService {
 	return &CancelOCOService{c: c}
@@ -687,6 +835,11 @@ func (c *Client) NewCancelOrderService() *CancelOrderService {
 	return &CancelOrderService{c: c}
 }
 
+// NewCancelReplaceOrderService init cancel replace order service
+func (c *Client) NewCancelReplaceOrderService() *CancelReplaceOrderService {
+	return &CancelReplaceOrderService{c: c}
+}
+
 // NewCancelOpenOrdersService init cancel open orders service
 func (c *Client) NewCancelOpenOrdersService() *CancelOpenOrdersService {
 	return &CancelOpenOrdersService{c: c}
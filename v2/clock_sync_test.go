@@ -0,0 +1,69 @@
+package binance
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type clockSyncTestSuite struct {
+	baseTestSuite
+}
+
+func TestClockSync(t *testing.T) {
+	suite.Run(t, new(clockSyncTestSuite))
+}
+
+func (s *clockSyncTestSuite) mockResponse(endpoint, data string, statusCode int) {
+	s.client.On("do", mock.MatchedBy(func(req *http.Request) bool {
+		return strings.HasSuffix(req.URL.Path, endpoint)
+	})).Return(&http.Response{
+		Body:       io.NopCloser(bytes.NewBufferString(data)),
+		StatusCode: statusCode,
+	}, nil).Once()
+}
+
+func (s *clockSyncTestSuite) TestResyncOnInvalidTimestamp() {
+	s.client.Client.do = s.client.do
+	s.client.ClockSync = s.client.NewClockSync()
+	s.client.ClockSync.Samples = 1
+	s.client.TimeOffset = 1000000
+
+	var timestamps []int64
+	s.assertReq(func(r *request) {
+		if ts := r.query.Get(timestampKey); ts != "" {
+			ts, err := strconv.ParseInt(ts, 10, 64)
+			s.r().NoError(err)
+			timestamps = append(timestamps, ts)
+		}
+	})
+	s.mockResponse("/api/v3/account", `{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`, http.StatusBadRequest)
+	s.mockResponse("/api/v3/time", fmt.Sprintf(`{"serverTime":%d}`, currentTimestamp()), http.StatusOK)
+	s.mockResponse("/api/v3/account", `{"makerCommission":15}`, http.StatusOK)
+
+	res, err := s.client.NewGetAccountService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(15), res.MakerCommission)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+
+	s.r().Len(timestamps, 2)
+	offset, ok := s.client.ClockSync.Offset()
+	s.r().True(ok)
+	s.r().Less(offset, int64(1000))
+	s.r().Greater(timestamps[1]-timestamps[0], int64(900000))
+}
+
+func (s *clockSyncTestSuite) TestNoResyncWithoutClockSync() {
+	s.mockDo([]byte(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`), nil, http.StatusBadRequest)
+
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	s.r().Error(err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
@@ -0,0 +1,186 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	defaultClockSyncInterval = time.Minute
+	defaultClockSyncSamples  = 3
+)
+
+// ServerTimeFunc return the server time in milliseconds
+type ServerTimeFunc func(ctx context.Context) (int64, error)
+
+// ClockSyncStats define the drift metrics of a ClockSync
+type ClockSyncStats struct {
+	// Offset is the local time minus the server time in milliseconds, as Client.TimeOffset
+	Offset int64
+	// Drift is the change of Offset since the previous sync in milliseconds
+	Drift int64
+	// MaxDrift is the largest absolute Drift seen so far in milliseconds
+	MaxDrift int64
+	// RoundTrip is the round trip time of the sample used to estimate Offset
+	RoundTrip time.Duration
+	LastSync  time.Time
+	Syncs     int64
+	Errors    int64
+	LastError error
+}
+
+// ClockSync keeps the offset between the local clock and the server clock in sync.
+// The offset is estimated from the server time and the round trip latency of the request,
+// using the sample with the lowest round trip.
+type ClockSync struct {
+	// Interval between two syncs of the background loop
+	Interval time.Duration
+	// Samples is the number of server time requests done per sync
+	Samples int
+
+	serverTime ServerTimeFunc
+	now        func() time.Time
+
+	mu     sync.Mutex
+	synced bool
+	stats  ClockSyncStats
+	stopC  chan struct{}
+}
+
+// NewClockSync init a ClockSync using serverTime to poll the server clock
+func NewClockSync(serverTime ServerTimeFunc) *ClockSync {
+	return &ClockSync{
+		Interval:   defaultClockSyncInterval,
+		Samples:    defaultClockSyncSamples,
+		serverTime: serverTime,
+		now:        time.Now,
+	}
+}
+
+// Sync estimates the clock offset once
+func (s *ClockSync) Sync(ctx context.Context) error {
+	samples := s.Samples
+	if samples <= 0 {
+		samples = 1
+	}
+	var (
+		bestOffset int64
+		bestRTT    time.Duration = -1
+		lastErr    error
+	)
+	for i := 0; i < samples; i++ {
+		sent := s.now()
+		serverTime, err := s.serverTime(ctx)
+		received := s.now()
+		if err != nil {
+			lastErr = err
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		rtt := received.Sub(sent)
+		if bestRTT < 0 || rtt < bestRTT {
+			bestRTT = rtt
+			// the server time is assumed to be taken halfway through the round trip
+			bestOffset = sent.Add(rtt/2).UnixMilli() - serverTime
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if bestRTT < 0 {
+		s.stats.Errors++
+		s.stats.LastError = lastErr
+		return lastErr
+	}
+	if s.synced {
+		s.stats.Drift = bestOffset - s.stats.Offset
+		drift := s.stats.Drift
+		if drift < 0 {
+			drift = -drift
+		}
+		if drift > s.stats.MaxDrift {
+			s.stats.MaxDrift = drift
+		}
+	}
+	s.synced = true
+	s.stats.Offset = bestOffset
+	s.stats.RoundTrip = bestRTT
+	s.stats.LastSync = s.now()
+	s.stats.Syncs++
+	return nil
+}
+
+// Start syncs the clock in the background every Interval until ctx is done or Stop is called
+func (s *ClockSync) Start(ctx context.Context) {
+	s.mu.Lock()
+	if s.stopC != nil {
+		s.mu.Unlock()
+		return
+	}
+	stopC := make(chan struct{})
+	s.stopC = stopC
+	s.mu.Unlock()
+
+	go func() {
+		interval := s.Interval
+		if interval <= 0 {
+			interval = defaultClockSyncInterval
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			_ = s.Sync(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-stopC:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop stops the background loop
+func (s *ClockSync) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopC != nil {
+		close(s.stopC)
+		s.stopC = nil
+	}
+}
+
+// Offset return the local time minus the server time in milliseconds, ok is false until the first successful sync
+func (s *ClockSync) Offset() (offset int64, ok bool) {
+	if s == nil {
+		return 0, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stats.Offset, s.synced
+}
+
+// Stats return the drift metrics
+func (s *ClockSync) Stats() ClockSyncStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stats
+}
+
+// Resync syncs the clock if err is a -1021 timestamp error and reports whether the
+// request should be re-issued with a new timestamp
+func (s *ClockSync) Resync(ctx context.Context, err error) bool {
+	if s == nil || err == nil {
+		return false
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || ErrorCode(apiErr.Code) != ErrorCodeInvalidTimestamp {
+		return false
+	}
+	return s.Sync(ctx) == nil
+}
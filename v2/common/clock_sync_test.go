@@ -0,0 +1,102 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock advances by step on every call
+type fakeClock struct {
+	t    time.Time
+	step time.Duration
+}
+
+func (c *fakeClock) now() time.Time {
+	t := c.t
+	c.t = c.t.Add(c.step)
+	return t
+}
+
+func TestClockSyncSync(t *testing.T) {
+	clock := &fakeClock{t: time.UnixMilli(1000000), step: 20 * time.Millisecond}
+	serverOffset := int64(500)
+	s := NewClockSync(func(ctx context.Context) (int64, error) {
+		// server clock is behind the local clock by serverOffset
+		return clock.t.UnixMilli() - serverOffset, nil
+	})
+	s.now = clock.now
+	s.Samples = 1
+
+	_, ok := s.Offset()
+	assert.False(t, ok)
+
+	require.NoError(t, s.Sync(context.Background()))
+	offset, ok := s.Offset()
+	assert.True(t, ok)
+	// the request is sent at 1000000 and received at 1000020, the server time is read at 1000020
+	assert.Equal(t, int64(490), offset)
+	stats := s.Stats()
+	assert.Equal(t, 20*time.Millisecond, stats.RoundTrip)
+	assert.Equal(t, int64(1), stats.Syncs)
+	assert.Equal(t, int64(0), stats.Drift)
+
+	serverOffset = 530
+	require.NoError(t, s.Sync(context.Background()))
+	stats = s.Stats()
+	assert.Equal(t, int64(520), stats.Offset)
+	assert.Equal(t, int64(30), stats.Drift)
+	assert.Equal(t, int64(30), stats.MaxDrift)
+}
+
+func TestClockSyncError(t *testing.T) {
+	fetchErr := errors.New("network error")
+	s := NewClockSync(func(ctx context.Context) (int64, error) {
+		return 0, fetchErr
+	})
+	assert.Equal(t, fetchErr, s.Sync(context.Background()))
+	stats := s.Stats()
+	assert.Equal(t, int64(1), stats.Errors)
+	assert.Equal(t, fetchErr, stats.LastError)
+	_, ok := s.Offset()
+	assert.False(t, ok)
+}
+
+func TestClockSyncResync(t *testing.T) {
+	calls := 0
+	s := NewClockSync(func(ctx context.Context) (int64, error) {
+		calls++
+		return time.Now().UnixMilli(), nil
+	})
+	s.Samples = 1
+	ctx := context.Background()
+
+	assert.False(t, s.Resync(ctx, nil))
+	assert.False(t, s.Resync(ctx, &APIError{Code: -1022}))
+	assert.Equal(t, 0, calls)
+	assert.True(t, s.Resync(ctx, &APIError{Code: -1021}))
+	assert.Equal(t, 1, calls)
+
+	var nilSync *ClockSync
+	assert.False(t, nilSync.Resync(ctx, &APIError{Code: -1021}))
+}
+
+func TestClockSyncStartStop(t *testing.T) {
+	synced := make(chan struct{}, 10)
+	s := NewClockSync(func(ctx context.Context) (int64, error) {
+		synced <- struct{}{}
+		return time.Now().UnixMilli(), nil
+	})
+	s.Samples = 1
+	s.Interval = time.Millisecond
+	s.Start(context.Background())
+	<-synced
+	<-synced
+	s.Stop()
+	_, ok := s.Offset()
+	assert.True(t, ok)
+}
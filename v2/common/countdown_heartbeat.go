@@ -0,0 +1,137 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrorHeartbeatInterval is returned by Start when the heartbeat interval is not shorter than the countdown
+	ErrorHeartbeatInterval = errors.New("countdown heartbeat: interval must be positive and shorter than the countdown")
+	// ErrorCountdownTooShort is returned by Start when the countdown is shorter than MinCountdown
+	ErrorCountdownTooShort = errors.New("countdown heartbeat: countdown shorter than the min countdown")
+)
+
+// CountdownFunc set the countdown of a countdown cancel-all, a zero countdown disables it
+type CountdownFunc func(ctx context.Context, countdown time.Duration) error
+
+// HeartbeatFunc reset the countdown of a countdown cancel-all
+type HeartbeatFunc func(ctx context.Context) error
+
+// CountdownHeartbeat keeps a countdown cancel-all (dead man's switch) armed: the countdown is set on Start
+// and reset every Interval. When the heartbeats stop, because the process died, the connectivity was lost
+// or ctx is done, the open orders are cancelled by the exchange once the countdown expires.
+type CountdownHeartbeat struct {
+	// Countdown after which the open orders are cancelled if no heartbeat is received
+	Countdown time.Duration
+	// Interval between two heartbeats, a third of Countdown when zero
+	Interval time.Duration
+	// MinCountdown is the shortest countdown accepted by the exchange, no min when zero
+	MinCountdown time.Duration
+	// ErrHandler is called for the failed heartbeats, the heartbeats go on
+	ErrHandler func(err error)
+
+	set   CountdownFunc
+	reset HeartbeatFunc
+
+	mu    sync.Mutex
+	stopC chan struct{}
+	doneC chan struct{}
+}
+
+// NewCountdownHeartbeat init a CountdownHeartbeat using set to set the countdown, and reset to
+// reset it on each heartbeat. The countdown is set again on each heartbeat when reset is nil.
+func NewCountdownHeartbeat(set CountdownFunc, reset HeartbeatFunc, countdown time.Duration) *CountdownHeartbeat {
+	return &CountdownHeartbeat{
+		Countdown: countdown,
+		set:       set,
+		reset:     reset,
+	}
+}
+
+// Start sets the countdown and resets it in the background every Interval until ctx is done or Stop is called,
+// the error of the first set is returned and the heartbeats are not started. The countdown is not set when
+// it is shorter than MinCountdown or when Interval is not shorter than the countdown.
+func (h *CountdownHeartbeat) Start(ctx context.Context) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.stopC != nil {
+		select {
+		case <-h.doneC:
+			// the heartbeats stopped with the context of the previous Start
+		default:
+			return nil
+		}
+	}
+	if h.Countdown < h.MinCountdown {
+		return ErrorCountdownTooShort
+	}
+	interval := h.Interval
+	if interval == 0 {
+		interval = h.Countdown / 3
+	}
+	if interval <= 0 || interval >= h.Countdown {
+		return ErrorHeartbeatInterval
+	}
+	if err := h.set(ctx, h.Countdown); err != nil {
+		return err
+	}
+	stopC := make(chan struct{})
+	doneC := make(chan struct{})
+	h.stopC = stopC
+	h.doneC = doneC
+
+	go func() {
+		defer close(doneC)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-stopC:
+				return
+			case <-ticker.C:
+				if err := h.beat(ctx); err != nil && ctx.Err() == nil && h.ErrHandler != nil {
+					h.ErrHandler(err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (h *CountdownHeartbeat) beat(ctx context.Context) error {
+	if h.reset != nil {
+		return h.reset(ctx)
+	}
+	return h.set(ctx, h.Countdown)
+}
+
+// Stop stops the heartbeats and waits for the background loop to return, the countdown is left armed
+func (h *CountdownHeartbeat) Stop() {
+	h.mu.Lock()
+	stopC, doneC := h.stopC, h.doneC
+	h.stopC, h.doneC = nil, nil
+	h.mu.Unlock()
+	if stopC == nil {
+		return
+	}
+	close(stopC)
+	<-doneC
+}
+
+// Disable stops the heartbeats and disables the countdown, the open orders are left untouched
+func (h *CountdownHeartbeat) Disable(ctx context.Context) error {
+	h.Stop()
+	return h.set(ctx, 0)
+}
+
+// Done return a channel closed once the heartbeats have stopped, nil if they are not started
+func (h *CountdownHeartbeat) Done() <-chan struct{} {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.doneC
+}
@@ -0,0 +1,165 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countdownRecorder records the countdowns set and the heartbeats
+type countdownRecorder struct {
+	mu         sync.Mutex
+	countdowns []time.Duration
+	resets     int
+	err        error
+}
+
+func (r *countdownRecorder) set(ctx context.Context, countdown time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.countdowns = append(r.countdowns, countdown)
+	return r.err
+}
+
+func (r *countdownRecorder) reset(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.resets++
+	return r.err
+}
+
+func (r *countdownRecorder) stats() ([]time.Duration, int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]time.Duration(nil), r.countdowns...), r.resets
+}
+
+func TestCountdownHeartbeatSetsCountdownOnEachBeat(t *testing.T) {
+	rec := &countdownRecorder{}
+	h := NewCountdownHeartbeat(rec.set, nil, time.Minute)
+	assert.Zero(t, h.Interval)
+	h.Interval = 5 * time.Millisecond
+
+	require.NoError(t, h.Start(context.Background()))
+	assert.Eventually(t, func() bool {
+		countdowns, _ := rec.stats()
+		return len(countdowns) >= 3
+	}, time.Second, time.Millisecond)
+	h.Stop()
+
+	countdowns, _ := rec.stats()
+	for _, countdown := range countdowns {
+		assert.Equal(t, time.Minute, countdown)
+	}
+	select {
+	case <-h.Done():
+		t.Fatal("Done should be nil once stopped")
+	default:
+	}
+}
+
+func TestCountdownHeartbeatReset(t *testing.T) {
+	rec := &countdownRecorder{}
+	h := NewCountdownHeartbeat(rec.set, rec.reset, time.Minute)
+	h.Interval = 5 * time.Millisecond
+
+	require.NoError(t, h.Start(context.Background()))
+	assert.Eventually(t, func() bool {
+		_, resets := rec.stats()
+		return resets >= 2
+	}, time.Second, time.Millisecond)
+
+	require.NoError(t, h.Disable(context.Background()))
+	countdowns, resets := rec.stats()
+	assert.Equal(t, []time.Duration{time.Minute, 0}, countdowns)
+
+	// no heartbeat once disabled
+	time.Sleep(20 * time.Millisecond)
+	_, after := rec.stats()
+	assert.Equal(t, resets, after)
+}
+
+func TestCountdownHeartbeatStopsOnContextDone(t *testing.T) {
+	rec := &countdownRecorder{}
+	h := NewCountdownHeartbeat(rec.set, rec.reset, time.Minute)
+	h.Interval = 5 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, h.Start(ctx))
+	doneC := h.Done()
+	cancel()
+	select {
+	case <-doneC:
+	case <-time.After(time.Second):
+		t.Fatal("heartbeats not stopped")
+	}
+	countdowns, _ := rec.stats()
+	// the countdown is left armed
+	assert.Equal(t, []time.Duration{time.Minute}, countdowns)
+
+	// can be started again
+	require.NoError(t, h.Start(context.Background()))
+	assert.NotEqual(t, doneC, h.Done())
+	h.Stop()
+}
+
+func TestCountdownHeartbeatErrors(t *testing.T) {
+	rec := &countdownRecorder{err: errors.New("dummy error")}
+	h := NewCountdownHeartbeat(rec.set, rec.reset, time.Minute)
+	assert.EqualError(t, h.Start(context.Background()), "dummy error")
+	assert.Nil(t, h.Done())
+
+	rec.err = nil
+	errC := make(chan error, 1)
+	h.Interval = 5 * time.Millisecond
+	h.ErrHandler = func(err error) {
+		select {
+		case errC <- err:
+		default:
+		}
+	}
+	require.NoError(t, h.Start(context.Background()))
+	defer h.Stop()
+	rec.mu.Lock()
+	rec.err = errors.New("heartbeat error")
+	rec.mu.Unlock()
+	select {
+	case err := <-errC:
+		assert.EqualError(t, err, "heartbeat error")
+	case <-time.After(time.Second):
+		t.Fatal("ErrHandler not called")
+	}
+}
+
+func TestCountdownHeartbeatInterval(t *testing.T) {
+	rec := &countdownRecorder{}
+	h := NewCountdownHeartbeat(rec.set, rec.reset, time.Minute)
+	assert.Zero(t, h.Interval)
+
+	// the interval is derived from the countdown changed after the init
+	h.Countdown = 30 * time.Millisecond
+	require.NoError(t, h.Start(context.Background()))
+	time.Sleep(50 * time.Millisecond)
+	h.Stop()
+	countdowns, resets := rec.stats()
+	assert.Equal(t, []time.Duration{30 * time.Millisecond}, countdowns)
+	assert.NotZero(t, resets)
+
+	h.Interval = h.Countdown
+	assert.Equal(t, ErrorHeartbeatInterval, h.Start(context.Background()))
+	h.Interval = -time.Second
+	assert.Equal(t, ErrorHeartbeatInterval, h.Start(context.Background()))
+	h.Interval, h.Countdown = 0, 0
+	assert.Equal(t, ErrorHeartbeatInterval, h.Start(context.Background()))
+	assert.Nil(t, h.Done())
+
+	h.Countdown, h.MinCountdown = time.Second, 5*time.Second
+	assert.Equal(t, ErrorCountdownTooShort, h.Start(context.Background()))
+	countdowns, _ = rec.stats()
+	assert.Len(t, countdowns, 1)
+}
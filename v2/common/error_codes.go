@@ -0,0 +1,226 @@
+package common
+
+import "fmt"
+
+// ErrorCode define a Binance error code, errors.Is(err, code) reports whether err is an APIError with this code
+type ErrorCode int64
+
+// Error return the error code
+func (c ErrorCode) Error() string {
+	return fmt.Sprintf("<APIError> code=%d", int64(c))
+}
+
+// ErrorCategory define the category of an error code
+type ErrorCategory string
+
+// Global enums
+const (
+	ErrorCategoryGeneral    ErrorCategory = "GENERAL"    // 10xx - General Server or Network issues
+	ErrorCategoryRequest    ErrorCategory = "REQUEST"    // 11xx - Request issues
+	ErrorCategoryProcessing ErrorCategory = "PROCESSING" // 20xx - Processing Issues
+	ErrorCategoryFilter     ErrorCategory = "FILTER"     // 40xx - Filters and Other Issues
+	ErrorCategoryExecution  ErrorCategory = "EXECUTION"  // 50xx - Order Execution Issues
+	ErrorCategoryUnknown    ErrorCategory = "UNKNOWN"
+)
+
+// Category return the category of the error code
+func (c ErrorCode) Category() ErrorCategory {
+	switch {
+	case c <= -1000 && c > -1100:
+		return ErrorCategoryGeneral
+	case c <= -1100 && c > -1200:
+		return ErrorCategoryRequest
+	case c <= -2000 && c > -2100:
+		return ErrorCategoryProcessing
+	case c <= -4000 && c > -5000:
+		return ErrorCategoryFilter
+	case c <= -5000 && c > -6000:
+		return ErrorCategoryExecution
+	}
+	return ErrorCategoryUnknown
+}
+
+// 10xx - General Server or Network issues
+const (
+	ErrorCodeUnknown              ErrorCode = -1000 // An unknown error occurred while processing the request
+	ErrorCodeDisconnected         ErrorCode = -1001 // Internal error; unable to process your request
+	ErrorCodeUnauthorized         ErrorCode = -1002 // You are not authorized to execute this request
+	ErrorCodeTooManyRequests      ErrorCode = -1003 // Too many requests
+	ErrorCodeDuplicateIP          ErrorCode = -1004 // This IP is already on the white list
+	ErrorCodeNoSuchIP             ErrorCode = -1005 // No such IP has been white listed
+	ErrorCodeUnexpectedResp       ErrorCode = -1006 // An unexpected response was received from the message bus
+	ErrorCodeTimeout              ErrorCode = -1007 // Timeout waiting for response from backend server
+	ErrorCodeServerBusy           ErrorCode = -1008 // Server is currently overloaded with other requests
+	ErrorCodeErrorMsgReceived     ErrorCode = -1010 // Error message received
+	ErrorCodeNonWhiteList         ErrorCode = -1011 // This IP cannot access this route
+	ErrorCodeInvalidMessage       ErrorCode = -1013 // Invalid message
+	ErrorCodeUnknownOrderCompose  ErrorCode = -1014 // Unsupported order combination
+	ErrorCodeTooManyOrders        ErrorCode = -1015 // Too many new orders
+	ErrorCodeServiceShuttingDown  ErrorCode = -1016 // This service is no longer available
+	ErrorCodeUnsupportedOperation ErrorCode = -1020 // This operation is not supported
+	ErrorCodeInvalidTimestamp     ErrorCode = -1021 // Timestamp for this request is outside of the recvWindow
+	ErrorCodeInvalidSignature     ErrorCode = -1022 // Signature for this request is not valid
+	ErrorCodeStartTimeGreaterEnd  ErrorCode = -1023 // Start time is greater than end time
+	ErrorCodeTooManyConnections   ErrorCode = -1034 // Too many concurrent connections
+)
+
+// 11xx - Request issues
+const (
+	ErrorCodeIllegalChars                   ErrorCode = -1100 // Illegal characters found in parameter
+	ErrorCodeTooManyParameters              ErrorCode = -1101 // Too many parameters sent for this endpoint
+	ErrorCodeMandatoryParamEmptyOrMalformed ErrorCode = -1102 // Mandatory parameter was not sent, empty/null, or malformed
+	ErrorCodeUnknownParam                   ErrorCode = -1103 // An unknown parameter was sent
+	ErrorCodeUnreadParameters               ErrorCode = -1104 // Not all sent parameters were read
+	ErrorCodeParamEmpty                     ErrorCode = -1105 // Parameter was empty
+	ErrorCodeParamNotRequired               ErrorCode = -1106 // Parameter was sent when not required
+	ErrorCodeBadAsset                       ErrorCode = -1108 // Invalid asset
+	ErrorCodeBadAccount                     ErrorCode = -1109 // Invalid account
+	ErrorCodeBadInstrumentType              ErrorCode = -1110 // Invalid symbolType
+	ErrorCodeBadPrecision                   ErrorCode = -1111 // Precision is over the maximum defined
+	ErrorCodeNoDepth                        ErrorCode = -1112 // No orders on book for symbol
+	ErrorCodeWithdrawNotNegative            ErrorCode = -1113 // Withdrawal amount must be negative
+	ErrorCodeTIFNotRequired                 ErrorCode = -1114 // TimeInForce parameter sent when not required
+	ErrorCodeInvalidTIF                     ErrorCode = -1115 // Invalid timeInForce
+	ErrorCodeInvalidOrderType               ErrorCode = -1116 // Invalid orderType
+	ErrorCodeInvalidSide                    ErrorCode = -1117 // Invalid side
+	ErrorCodeEmptyNewClOrdID                ErrorCode = -1118 // New client order ID was empty
+	ErrorCodeEmptyOrgClOrdID                ErrorCode = -1119 // Original client order ID was empty
+	ErrorCodeBadInterval                    ErrorCode = -1120 // Invalid interval
+	ErrorCodeBadSymbol                      ErrorCode = -1121 // Invalid symbol
+	ErrorCodeInvalidListenKey               ErrorCode = -1125 // This listenKey does not exist
+	ErrorCodeMoreThanXXHours                ErrorCode = -1127 // Lookup interval is too big
+	ErrorCodeOptionalParamsBadCombo         ErrorCode = -1128 // Combination of optional parameters invalid
+	ErrorCodeInvalidParameter               ErrorCode = -1130 // Invalid data sent for a parameter
+	ErrorCodeBadRecvWindow                  ErrorCode = -1131 // recvWindow must be less than 60000
+	ErrorCodeInvalidNewOrderRespType        ErrorCode = -1136 // Invalid newOrderRespType
+)
+
+// 20xx - Processing Issues
+const (
+	ErrorCodeNewOrderRejected                ErrorCode = -2010 // NEW_ORDER_REJECTED
+	ErrorCodeCancelRejected                  ErrorCode = -2011 // CANCEL_REJECTED
+	ErrorCodeNoSuchOrder                     ErrorCode = -2013 // Order does not exist
+	ErrorCodeBadAPIKeyFmt                    ErrorCode = -2014 // API-key format invalid
+	ErrorCodeRejectedMBXKey                  ErrorCode = -2015 // Invalid API-key, IP, or permissions
+	ErrorCodeNoTradingWindow                 ErrorCode = -2016 // No trading window could be found
+	ErrorCodeBalanceNotSufficient            ErrorCode = -2018 // Balance is insufficient
+	ErrorCodeMarginNotSufficient             ErrorCode = -2019 // Margin is insufficient
+	ErrorCodeUnableToFill                    ErrorCode = -2020 // Unable to fill
+	ErrorCodeOrderWouldImmediatelyTrigger    ErrorCode = -2021 // Order would immediately trigger
+	ErrorCodeReduceOnlyReject                ErrorCode = -2022 // ReduceOnly Order is rejected
+	ErrorCodeUserInLiquidation               ErrorCode = -2023 // User in liquidation mode now
+	ErrorCodePositionNotSufficient           ErrorCode = -2024 // Position is not sufficient
+	ErrorCodeMaxOpenOrderExceeded            ErrorCode = -2025 // Max open order exceeded
+	ErrorCodeReduceOnlyOrderTypeNotSupported ErrorCode = -2026 // Reduce only order type not supported
+	ErrorCodeMaxLeverageRatio                ErrorCode = -2027 // Max leverage ratio reached
+	ErrorCodeMinLeverageRatio                ErrorCode = -2028 // Min leverage ratio reached
+)
+
+// 40xx - Filters and Other Issues
+const (
+	ErrorCodeInvalidOrderStatus                 ErrorCode = -4000 // Invalid order status
+	ErrorCodePriceLessThanZero                  ErrorCode = -4001 // Price less than zero
+	ErrorCodePriceGreaterThanMaxPrice           ErrorCode = -4002 // Price greater than max price
+	ErrorCodeQtyLessThanZero                    ErrorCode = -4003 // Quantity less than zero
+	ErrorCodeQtyLessThanMinQty                  ErrorCode = -4004 // Quantity less than min quantity
+	ErrorCodeQtyGreaterThanMaxQty               ErrorCode = -4005 // Quantity greater than max quantity
+	ErrorCodeStopPriceLessThanZero              ErrorCode = -4006 // Stop price less than zero
+	ErrorCodeStopPriceGreaterThanMaxPrice       ErrorCode = -4007 // Stop price greater than max price
+	ErrorCodeTickSizeLessThanZero               ErrorCode = -4008 // Tick size less than zero
+	ErrorCodeMaxPriceLessThanMinPrice           ErrorCode = -4009 // Max price less than min price
+	ErrorCodeMaxQtyLessThanMinQty               ErrorCode = -4010 // Max quantity less than min quantity
+	ErrorCodeStepSizeLessThanZero               ErrorCode = -4011 // Step size less than zero
+	ErrorCodeMaxNumOrdersLessThanZero           ErrorCode = -4012 // Max number of orders less than zero
+	ErrorCodePriceLessThanMinPrice              ErrorCode = -4013 // Price less than min price
+	ErrorCodePriceNotIncreasedByTickSize        ErrorCode = -4014 // Price not increased by tick size
+	ErrorCodeInvalidClOrdIDLen                  ErrorCode = -4015 // Invalid client order ID length
+	ErrorCodePriceHigherThanMultiplierUp        ErrorCode = -4016 // Price higher than multiplier up
+	ErrorCodeMultiplierUpLessThanZero           ErrorCode = -4017 // Multiplier up less than zero
+	ErrorCodeMultiplierDownLessThanZero         ErrorCode = -4018 // Multiplier down less than zero
+	ErrorCodeCompositeScaleOverflow             ErrorCode = -4019 // Composite scale overflow
+	ErrorCodeTargetStrategyInvalid              ErrorCode = -4020 // Target strategy invalid
+	ErrorCodeInvalidDepthLimit                  ErrorCode = -4021 // Invalid depth limit
+	ErrorCodeWrongMarketStatus                  ErrorCode = -4022 // Wrong market status
+	ErrorCodeQtyNotIncreasedByStepSize          ErrorCode = -4023 // Quantity not increased by step size
+	ErrorCodePriceLowerThanMultiplierDown       ErrorCode = -4024 // Price lower than multiplier down
+	ErrorCodeMultiplierDecimalLessThanZero      ErrorCode = -4025 // Multiplier decimal less than zero
+	ErrorCodeCommissionInvalid                  ErrorCode = -4026 // Commission invalid
+	ErrorCodeInvalidAccountType                 ErrorCode = -4027 // Invalid account type
+	ErrorCodeInvalidLeverage                    ErrorCode = -4028 // Invalid leverage
+	ErrorCodeInvalidTickSizePrecision           ErrorCode = -4029 // Invalid tick size precision
+	ErrorCodeInvalidStepSizePrecision           ErrorCode = -4030 // Invalid step size precision
+	ErrorCodeInvalidWorkingType                 ErrorCode = -4031 // Invalid working type
+	ErrorCodeExceedMaxCancelOrderSize           ErrorCode = -4032 // Exceed max cancel order size
+	ErrorCodeInsuranceAccountNotFound           ErrorCode = -4033 // Insurance account not found
+	ErrorCodeInvalidBalanceType                 ErrorCode = -4044 // Invalid balance type
+	ErrorCodeMaxStopOrderExceeded               ErrorCode = -4045 // Max stop order exceeded
+	ErrorCodeNoNeedToChangeMarginType           ErrorCode = -4046 // No need to change margin type
+	ErrorCodeThereExistsOpenOrders              ErrorCode = -4047 // There exists open orders
+	ErrorCodeThereExistsQuantity                ErrorCode = -4048 // There exists quantity
+	ErrorCodeAddIsolatedMarginReject            ErrorCode = -4049 // Add isolated margin reject
+	ErrorCodeCrossBalanceInsufficient           ErrorCode = -4050 // Cross balance insufficient
+	ErrorCodeIsolatedBalanceInsufficient        ErrorCode = -4051 // Isolated balance insufficient
+	ErrorCodeNoNeedToChangeAutoAddMargin        ErrorCode = -4052 // No need to change auto add margin
+	ErrorCodeAutoAddCrossedMarginReject         ErrorCode = -4053 // Auto add crossed margin reject
+	ErrorCodeAddIsolatedMarginNoPositionReject  ErrorCode = -4054 // Add isolated margin no position reject
+	ErrorCodeAmountMustBePositive               ErrorCode = -4055 // Amount must be positive
+	ErrorCodeInvalidAPIKeyType                  ErrorCode = -4056 // Invalid API key type
+	ErrorCodeInvalidRSAPublicKey                ErrorCode = -4057 // Invalid RSA public key
+	ErrorCodeMaxPriceTooLarge                   ErrorCode = -4058 // Max price too large
+	ErrorCodeNoNeedToChangePositionSide         ErrorCode = -4059 // No need to change position side
+	ErrorCodeInvalidPositionSide                ErrorCode = -4060 // Invalid position side
+	ErrorCodePositionSideNotMatch               ErrorCode = -4061 // Position side not match
+	ErrorCodeReduceOnlyConflict                 ErrorCode = -4062 // Reduce only conflict
+	ErrorCodeInvalidOptionsRequestType          ErrorCode = -4063 // Invalid options request type
+	ErrorCodeInvalidOptionsTimeFrame            ErrorCode = -4064 // Invalid options time frame
+	ErrorCodeInvalidOptionsAmount               ErrorCode = -4065 // Invalid options amount
+	ErrorCodeInvalidOptionsEventType            ErrorCode = -4066 // Invalid options event type
+	ErrorCodePositionSideChangeExistsOpenOrders ErrorCode = -4067 // Position side change exists open orders
+	ErrorCodePositionSideChangeExistsQuantity   ErrorCode = -4068 // Position side change exists quantity
+	ErrorCodeInvalidOptionsPremiumFee           ErrorCode = -4069 // Invalid options premium fee
+	ErrorCodeInvalidClOptionsIDLen              ErrorCode = -4070 // Invalid cl options ID length
+	ErrorCodeInvalidOptionsDirection            ErrorCode = -4071 // Invalid options direction
+	ErrorCodeOptionsPremiumNotUpdate            ErrorCode = -4072 // Options premium not update
+	ErrorCodeOptionsPremiumInputLessThanZero    ErrorCode = -4073 // Options premium input less than zero
+	ErrorCodeOptionsAmountBiggerThanUpper       ErrorCode = -4074 // Options amount bigger than upper
+	ErrorCodeOptionsPremiumOutputZero           ErrorCode = -4075 // Options premium output zero
+	ErrorCodeOptionsPremiumTooDiff              ErrorCode = -4076 // Options premium too diff
+	ErrorCodeOptionsPremiumReachLimit           ErrorCode = -4077 // Options premium reach limit
+	ErrorCodeOptionsCommonError                 ErrorCode = -4078 // Options common error
+	ErrorCodeInvalidOptionsID                   ErrorCode = -4079 // Invalid options ID
+	ErrorCodeOptionsUserNotFound                ErrorCode = -4080 // Options user not found
+	ErrorCodeOptionsNotFound                    ErrorCode = -4081 // Options not found
+	ErrorCodeInvalidBatchPlaceOrderSize         ErrorCode = -4082 // Invalid batch place order size
+	ErrorCodePlaceBatchOrdersFail               ErrorCode = -4083 // Place batch orders fail
+	ErrorCodeUpcomingMethod                     ErrorCode = -4084 // Upcoming method
+	ErrorCodeInvalidNotionalLimitCoef           ErrorCode = -4085 // Invalid notional limit coefficient
+	ErrorCodeInvalidPriceSpreadThreshold        ErrorCode = -4086 // Invalid price spread threshold
+	ErrorCodeReduceOnlyOrderPermission          ErrorCode = -4087 // Reduce only order permission
+	ErrorCodeNoPlaceOrderPermission             ErrorCode = -4088 // No place order permission
+	ErrorCodeInvalidContractType                ErrorCode = -4104 // Invalid contract type
+	ErrorCodeInvalidClientTranIDLen             ErrorCode = -4114 // Invalid client transaction ID length
+	ErrorCodeDuplicatedClientTranID             ErrorCode = -4115 // Duplicated client transaction ID
+	ErrorCodeReduceOnlyMarginCheckFailed        ErrorCode = -4118 // Reduce only margin check failed
+	ErrorCodeMarketOrderReject                  ErrorCode = -4131 // Market order reject
+	ErrorCodeInvalidActivationPrice             ErrorCode = -4135 // Invalid activation price
+	ErrorCodeQuantityExistsWithClosePosition    ErrorCode = -4137 // Quantity exists with close position
+	ErrorCodeReduceOnlyMustBeTrue               ErrorCode = -4138 // Reduce only must be true
+	ErrorCodeOrderTypeCannotBeMKT               ErrorCode = -4139 // Order type cannot be MKT
+	ErrorCodeInvalidOpeningPositionStatus       ErrorCode = -4140 // Invalid opening position status
+	ErrorCodeSymbolAlreadyClosed                ErrorCode = -4141 // Symbol already closed
+	ErrorCodeStrategyInvalidTriggerPrice        ErrorCode = -4142 // Strategy invalid trigger price
+	ErrorCodeInvalidPair                        ErrorCode = -4144 // Invalid pair
+	ErrorCodeIsolatedLeverageRejectWithPosition ErrorCode = -4161 // Isolated leverage reject with position
+	ErrorCodeMinNotional                        ErrorCode = -4164 // Min notional
+	ErrorCodeInvalidTimeInterval                ErrorCode = -4165 // Invalid time interval
+	ErrorCodePriceHigherThanStopMultiplierUp    ErrorCode = -4183 // Price higher than stop multiplier up
+	ErrorCodePriceLowerThanStopMultiplierDown   ErrorCode = -4184 // Price lower than stop multiplier down
+)
+
+// 50xx - Order Execution Issues
+const (
+	ErrorCodeFOKOrderReject      ErrorCode = -5021 // FOK order rejected
+	ErrorCodeGTXOrderReject      ErrorCode = -5022 // GTX order rejected
+	ErrorCodeMERecvWindowReject  ErrorCode = -5028 // ME recvWindow rejected
+	ErrorCodeTooManyRequestQueue ErrorCode = -5041 // Too many requests in queue
+)
@@ -1,7 +1,9 @@
 package common
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 )
 
 // APIError define API error when response status is 4xx or 5xx
@@ -23,8 +25,66 @@ func (e APIError) IsValid() bool {
 	return e.Code != 0 || e.Message != ""
 }
 
-// IsAPIError check if e is an API error
+// Is reports whether target is the ErrorCode of e, for errors.Is
+func (e *APIError) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	return ok && e.Code == int64(code)
+}
+
+// IsAPIError check if e is an API error, or wraps one
 func IsAPIError(e error) bool {
-	_, ok := e.(*APIError)
-	return ok
+	var apiErr *APIError
+	return errors.As(e, &apiErr)
+}
+
+// GetErrorCode return the error code of the API error wrapped by e
+func GetErrorCode(e error) (ErrorCode, bool) {
+	var apiErr *APIError
+	if !errors.As(e, &apiErr) {
+		return 0, false
+	}
+	return ErrorCode(apiErr.Code), true
+}
+
+// IsRetryable check if e is an API error whose request was rejected before being processed,
+// so it can be sent again, see DefaultRetryableCodes
+func IsRetryable(e error) bool {
+	code, ok := GetErrorCode(e)
+	if !ok {
+		return false
+	}
+	for _, c := range DefaultRetryableCodes {
+		if int64(code) == c {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRateLimited check if e is caused by a request or order rate limit, either reported by Binance or by a RateLimiter
+func IsRateLimited(e error) bool {
+	var rlErr *RateLimitError
+	if errors.As(e, &rlErr) {
+		return true
+	}
+	return errors.Is(e, ErrorCodeTooManyRequests) ||
+		errors.Is(e, ErrorCodeTooManyOrders) ||
+		errors.Is(e, ErrorCodeTooManyRequestQueue)
+}
+
+// IsInsufficientBalance check if e is caused by an insufficient balance or margin.
+// Spot rejects the orders with ErrorCodeNewOrderRejected, so its message is checked too.
+func IsInsufficientBalance(e error) bool {
+	var apiErr *APIError
+	if !errors.As(e, &apiErr) {
+		return false
+	}
+	switch ErrorCode(apiErr.Code) {
+	case ErrorCodeBalanceNotSufficient, ErrorCodeMarginNotSufficient,
+		ErrorCodeCrossBalanceInsufficient, ErrorCodeIsolatedBalanceInsufficient:
+		return true
+	case ErrorCodeNewOrderRejected:
+		return strings.Contains(strings.ToLower(apiErr.Message), "insufficient balance")
+	}
+	return false
 }
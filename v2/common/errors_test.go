@@ -0,0 +1,66 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	err := fmt.Errorf("create order: %w", &APIError{Code: -1003, Message: "Too many requests"})
+	assert.True(t, errors.Is(err, ErrorCodeTooManyRequests))
+	assert.False(t, errors.Is(err, ErrorCodeTooManyOrders))
+	assert.True(t, IsAPIError(err))
+
+	code, ok := GetErrorCode(err)
+	assert.True(t, ok)
+	assert.Equal(t, ErrorCodeTooManyRequests, code)
+	assert.Equal(t, ErrorCategoryGeneral, code.Category())
+
+	_, ok = GetErrorCode(errors.New("network error"))
+	assert.False(t, ok)
+}
+
+func TestErrorCodeCategory(t *testing.T) {
+	tests := []struct {
+		code     ErrorCode
+		category ErrorCategory
+	}{
+		{ErrorCodeInvalidTimestamp, ErrorCategoryGeneral},
+		{ErrorCodeBadSymbol, ErrorCategoryRequest},
+		{ErrorCodeNewOrderRejected, ErrorCategoryProcessing},
+		{ErrorCodeMinNotional, ErrorCategoryFilter},
+		{ErrorCodeGTXOrderReject, ErrorCategoryExecution},
+		{ErrorCode(-9000), ErrorCategoryUnknown},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.category, test.code.Category(), test.code)
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	tests := []struct {
+		name                string
+		err                 error
+		retryable           bool
+		rateLimited         bool
+		insufficientBalance bool
+	}{
+		{"too many requests", &APIError{Code: -1003}, true, true, false},
+		{"too many orders", &APIError{Code: -1015}, true, true, false},
+		{"invalid timestamp", &APIError{Code: -1021}, true, false, false},
+		{"rate limiter", &RateLimitError{}, false, true, false},
+		{"spot insufficient balance", &APIError{Code: -2010, Message: "Account has insufficient balance for requested action."}, false, false, true},
+		{"new order rejected", &APIError{Code: -2010, Message: "Order would trigger immediately."}, false, false, false},
+		{"margin insufficient", &APIError{Code: -2019, Message: "Margin is insufficient."}, false, false, true},
+		{"bad symbol", &APIError{Code: -1121}, false, false, false},
+		{"network error", errors.New("network error"), false, false, false},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.retryable, IsRetryable(test.err), test.name)
+		assert.Equal(t, test.rateLimited, IsRateLimited(test.err), test.name)
+		assert.Equal(t, test.insufficientBalance, IsInsufficientBalance(test.err), test.name)
+	}
+}
@@ -22,6 +22,15 @@ func AmountToLotSize(amount, minQty, stepSize string, precision int) string {
 	return baseAmountDec.Add(minQtyDec).Truncate(int32(precision)).String()
 }
 
+// ToDecimal convert a price or quantity to a decimal, zero if s is empty.
+// An error is returned if s is not a valid decimal.
+func ToDecimal(s string) (decimal.Decimal, error) {
+	if s == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(s)
+}
+
 // ToJSONList convert v to json list if v is a map
 func ToJSONList(v []byte) []byte {
 	if len(v) > 0 && v[0] == '{' {
@@ -107,3 +107,40 @@ func TestAmountToLotSize(t *testing.T) {
 		})
 	}
 }
+
+func TestToDecimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    string
+		wantErr bool
+	}{
+		{name: "decimal", s: "0.10000001", want: "0.10000001"},
+		{name: "integer", s: "-12", want: "-12"},
+		{name: "empty", s: "", want: "0"},
+		{name: "malformed", s: "0.1.2", wantErr: true},
+		{name: "invalid", s: "invalid", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := ToDecimal(tt.s)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, d.String())
+		})
+	}
+}
+
+func TestPriceLevelParseDecimal(t *testing.T) {
+	assert := assert.New(t)
+	price, quantity, err := (&PriceLevel{Price: "0.00000001", Quantity: "123456789.12345678"}).ParseDecimal()
+	assert.NoError(err)
+	assert.Equal("0.00000001", price.String())
+	assert.Equal("123456789.12345678", quantity.String())
+
+	_, _, err = (&PriceLevel{Price: "0.1", Quantity: ""}).ParseDecimal()
+	assert.Error(err)
+}
@@ -0,0 +1,118 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Call describe a REST request seen by the interceptors, the signature and listen keys are redacted.
+// A retried request is seen once per attempt.
+type Call struct {
+	Method   string
+	Endpoint string
+	Query    url.Values
+	Form     url.Values
+	// Weight and Orders are the cost of the request, as estimated by the client
+	Weight int64
+	Orders int64
+	// Attempt is the zero based number of the attempt
+	Attempt int
+
+	// The following fields are set once the response is received
+
+	// StatusCode is zero if no response was received
+	StatusCode int
+	Header     http.Header
+	Latency    time.Duration
+	// UsedWeight and OrderCount are read from the X-MBX-USED-WEIGHT-* and X-MBX-ORDER-COUNT-* headers,
+	// by interval key, e.g. 1M, 10S, 1D
+	UsedWeight map[string]int64
+	OrderCount map[string]int64
+	// ErrorCode is the code of the API error, zero if the request succeeded or failed for another reason
+	ErrorCode ErrorCode
+}
+
+// Invoker sends the request of a call
+type Invoker func(ctx context.Context, call *Call) error
+
+// Interceptor is called around each request, it calls next to send the request and may return another error
+type Interceptor func(ctx context.Context, call *Call, next Invoker) error
+
+// NewCall init the Call of a request, the secrets of query and form are redacted
+func NewCall(method, endpoint string, query, form url.Values, weight, orders int64, attempt int) *Call {
+	return &Call{
+		Method:   method,
+		Endpoint: endpoint,
+		Query:    redactParams(query),
+		Form:     redactParams(form),
+		Weight:   weight,
+		Orders:   orders,
+		Attempt:  attempt,
+	}
+}
+
+func redactParams(values url.Values) url.Values {
+	redacted := url.Values{}
+	for k, v := range values {
+		redacted[k] = append([]string(nil), v...)
+	}
+	for k := range redacted {
+		if isRedactedParam(k) {
+			redacted.Set(k, Redacted)
+		}
+	}
+	return redacted
+}
+
+// Invoke calls the interceptors in order around send, which sends the request of call.
+// The response fields of call are set once send returns.
+func Invoke(ctx context.Context, interceptors []Interceptor, call *Call, send func(ctx context.Context) (*http.Response, error)) error {
+	next := func(ctx context.Context, call *Call) error {
+		start := time.Now()
+		res, err := send(ctx)
+		call.Latency = time.Since(start)
+		if res != nil {
+			call.StatusCode = res.StatusCode
+			call.Header = res.Header
+			call.UsedWeight, call.OrderCount = ParseUsedLimits(res.Header)
+		}
+		if code, ok := GetErrorCode(err); ok {
+			call.ErrorCode = code
+		}
+		return err
+	}
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, invoker := interceptors[i], next
+		next = func(ctx context.Context, call *Call) error {
+			return interceptor(ctx, call, invoker)
+		}
+	}
+	return next(ctx, call)
+}
+
+// ParseUsedLimits return the used weight and order count headers of a response by interval key, e.g. 1M, 10S, 1D
+func ParseUsedLimits(header http.Header) (usedWeight, orderCount map[string]int64) {
+	usedWeight = make(map[string]int64)
+	orderCount = make(map[string]int64)
+	for k, v := range header {
+		if len(v) == 0 {
+			continue
+		}
+		key := strings.ToUpper(k)
+		used, err := strconv.ParseInt(v[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(key, usedWeightHeaderPrefix):
+			usedWeight[strings.TrimPrefix(key, usedWeightHeaderPrefix)] = used
+		case strings.HasPrefix(key, orderCountHeaderPrefix):
+			orderCount[strings.TrimPrefix(key, orderCountHeaderPrefix)] = used
+		}
+	}
+	return usedWeight, orderCount
+}
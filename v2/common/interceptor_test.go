@@ -0,0 +1,61 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCallRedactsSecrets(t *testing.T) {
+	query := url.Values{"symbol": {"BTCUSDT"}, "signature": {"secret"}}
+	form := url.Values{"listenKey": {"secret"}}
+	call := NewCall(http.MethodPost, "/api/v3/order", query, form, 1, 1, 0)
+	assert.Equal(t, url.Values{"symbol": {"BTCUSDT"}, "signature": {Redacted}}, call.Query)
+	assert.Equal(t, url.Values{"listenKey": {Redacted}}, call.Form)
+	// the request is not modified
+	assert.Equal(t, "secret", query.Get("signature"))
+}
+
+func TestInvoke(t *testing.T) {
+	var order []string
+	interceptor := func(name string) Interceptor {
+		return func(ctx context.Context, call *Call, next Invoker) error {
+			order = append(order, name+" before")
+			err := next(ctx, call)
+			order = append(order, name+" after")
+			return err
+		}
+	}
+	header := http.Header{}
+	header.Set("X-Mbx-Used-Weight-1m", "20")
+	header.Set("X-Mbx-Order-Count-10s", "2")
+	call := NewCall(http.MethodPost, "/api/v3/order", nil, nil, 1, 1, 0)
+	err := Invoke(context.Background(), []Interceptor{interceptor("first"), interceptor("second")}, call, func(ctx context.Context) (*http.Response, error) {
+		order = append(order, "send")
+		return &http.Response{StatusCode: http.StatusBadRequest, Header: header}, &APIError{Code: -2010}
+	})
+	require.Error(t, err)
+	assert.Equal(t, []string{"first before", "second before", "send", "second after", "first after"}, order)
+	assert.Equal(t, http.StatusBadRequest, call.StatusCode)
+	assert.Equal(t, ErrorCodeNewOrderRejected, call.ErrorCode)
+	assert.Equal(t, map[string]int64{"1M": 20}, call.UsedWeight)
+	assert.Equal(t, map[string]int64{"10S": 2}, call.OrderCount)
+}
+
+func TestInvokeInterceptorError(t *testing.T) {
+	blocked := errors.New("blocked")
+	call := NewCall(http.MethodGet, "/api/v3/depth", nil, nil, 5, 0, 0)
+	err := Invoke(context.Background(), []Interceptor{func(ctx context.Context, call *Call, next Invoker) error {
+		return blocked
+	}}, call, func(ctx context.Context) (*http.Response, error) {
+		t.Fatal("unexpected request")
+		return nil, nil
+	})
+	assert.Equal(t, blocked, err)
+	assert.Zero(t, call.StatusCode)
+}
@@ -0,0 +1,193 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Redacted replaces the secrets in the logs and in the requests seen by the interceptors
+const Redacted = "REDACTED"
+
+// redactedParams are the request parameters which are never logged nor passed to the interceptors
+var redactedParams = []string{"signature", "apiKey", "listenKey"}
+
+// redactedHeaders are the request headers which are never logged
+var redactedHeaders = []string{"X-MBX-APIKEY"}
+
+// LogLevel define the severity of a log
+type LogLevel int
+
+const (
+	LogLevelDebug LogLevel = iota
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+)
+
+// String return the name of the level
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "DEBUG"
+	case LogLevelInfo:
+		return "INFO"
+	case LogLevelWarn:
+		return "WARN"
+	case LogLevelError:
+		return "ERROR"
+	}
+	return "LEVEL(" + strconv.Itoa(int(l)) + ")"
+}
+
+// Logger define a structured logger, args are alternating keys and values, e.g. "status", 200.
+// *slog.Logger implements Logger. The secrets are redacted before being logged.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// NopLogger discards all logs
+var NopLogger Logger = nopLogger{}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(msg string, args ...interface{}) {}
+func (nopLogger) Info(msg string, args ...interface{})  {}
+func (nopLogger) Warn(msg string, args ...interface{})  {}
+func (nopLogger) Error(msg string, args ...interface{}) {}
+
+// stdLogger writes the logs from level to a *log.Logger as "LEVEL msg key=value ..."
+type stdLogger struct {
+	logger *log.Logger
+	level  LogLevel
+}
+
+// NewStdLogger init a Logger writing the logs from level to logger
+func NewStdLogger(logger *log.Logger, level LogLevel) Logger {
+	return &stdLogger{logger: logger, level: level}
+}
+
+func (l *stdLogger) Debug(msg string, args ...interface{}) { l.log(LogLevelDebug, msg, args) }
+func (l *stdLogger) Info(msg string, args ...interface{})  { l.log(LogLevelInfo, msg, args) }
+func (l *stdLogger) Warn(msg string, args ...interface{})  { l.log(LogLevelWarn, msg, args) }
+func (l *stdLogger) Error(msg string, args ...interface{}) { l.log(LogLevelError, msg, args) }
+
+func (l *stdLogger) log(level LogLevel, msg string, args []interface{}) {
+	if level < l.level {
+		return
+	}
+	var b strings.Builder
+	b.WriteString(level.String())
+	b.WriteString(" ")
+	b.WriteString(msg)
+	for i := 0; i < len(args); i += 2 {
+		key, value := fmt.Sprint(args[i]), "!MISSING"
+		if i+1 < len(args) {
+			value = fmt.Sprint(args[i+1])
+		}
+		if strings.ContainsAny(value, " \t\n\"=") {
+			value = strconv.Quote(value)
+		}
+		b.WriteString(" ")
+		b.WriteString(key)
+		b.WriteString("=")
+		b.WriteString(value)
+	}
+	l.logger.Println(b.String())
+}
+
+func isRedactedParam(key string) bool {
+	for _, k := range redactedParams {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactQuery redacts the secrets of an url encoded query or form body, the order of the parameters is kept
+func RedactQuery(query string) string {
+	params := strings.Split(query, "&")
+	for i, param := range params {
+		key, _, _ := strings.Cut(param, "=")
+		if isRedactedParam(key) {
+			params[i] = key + "=" + Redacted
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// RedactURL redacts the secrets of the query of an url
+func RedactURL(rawURL string) string {
+	u, query, ok := strings.Cut(rawURL, "?")
+	if !ok {
+		return rawURL
+	}
+	return u + "?" + RedactQuery(query)
+}
+
+// RedactHeader return a copy of header whose secrets are redacted
+func RedactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for _, k := range redactedHeaders {
+		if redacted.Get(k) != "" {
+			redacted.Set(k, Redacted)
+		}
+	}
+	return redacted
+}
+
+// RedactJSON redacts the secrets of a json message, e.g. a websocket API request,
+// the whole message is redacted if it is not valid json
+func RedactJSON(data []byte) string {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return Redacted
+	}
+	redacted, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return Redacted
+	}
+	return string(redacted)
+}
+
+// RedactedJSON is a json message whose secrets are redacted when it is logged,
+// the message is only decoded if the log is written, e.g. at the debug level
+type RedactedJSON []byte
+
+// String return the redacted message, see RedactJSON
+func (m RedactedJSON) String() string {
+	return RedactJSON(m)
+}
+
+// MarshalText return the redacted message, it is used by the slog handlers
+func (m RedactedJSON) MarshalText() ([]byte, error) {
+	return []byte(RedactJSON(m)), nil
+}
+
+func redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			if isRedactedParam(k) {
+				v[k] = Redacted
+			} else {
+				v[k] = redactValue(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+	}
+	return v
+}
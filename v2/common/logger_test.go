@@ -0,0 +1,60 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStdLogger(t *testing.T) {
+	var b bytes.Buffer
+	logger := NewStdLogger(log.New(&b, "", 0), LogLevelInfo)
+	logger.Debug("request", "url", "/api/v3/time")
+	logger.Warn("retry request", "delay", "1s", "error", errors.New("server error"), "attempt")
+	assert.Equal(t, "WARN retry request delay=1s error=\"server error\" attempt=!MISSING\n", b.String())
+}
+
+func TestRedactQuery(t *testing.T) {
+	assert.Equal(t, "symbol=BTCUSDT&timestamp=1&signature=REDACTED",
+		RedactQuery("symbol=BTCUSDT&timestamp=1&signature=abc"))
+	assert.Equal(t, "listenKey=REDACTED", RedactQuery("listenKey=abc"))
+	assert.Equal(t, "", RedactQuery(""))
+	assert.Equal(t, "https://api.binance.com/api/v3/order?symbol=BTCUSDT&signature=REDACTED",
+		RedactURL("https://api.binance.com/api/v3/order?symbol=BTCUSDT&signature=abc"))
+	assert.Equal(t, "https://api.binance.com/api/v3/time", RedactURL("https://api.binance.com/api/v3/time"))
+}
+
+func TestRedactHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("X-MBX-APIKEY", "key")
+	header.Set("Content-Type", "application/x-www-form-urlencoded")
+	redacted := RedactHeader(header)
+	assert.Equal(t, Redacted, redacted.Get("X-MBX-APIKEY"))
+	assert.Equal(t, "application/x-www-form-urlencoded", redacted.Get("Content-Type"))
+	assert.Equal(t, "key", header.Get("X-MBX-APIKEY"))
+}
+
+func TestRedactJSON(t *testing.T) {
+	assert.Equal(t, `{"id":"1","method":"order.place","params":{"apiKey":"REDACTED","price":"0.1","quantity":10000000000000000001,"signature":"REDACTED"}}`,
+		RedactJSON([]byte(`{"id":"1","method":"order.place","params":{"apiKey":"key","signature":"abc","price":"0.1","quantity":10000000000000000001}}`)))
+	assert.Equal(t, `[{"listenKey":"REDACTED"}]`, RedactJSON([]byte(`[{"listenKey":"abc"}]`)))
+	assert.Equal(t, Redacted, RedactJSON([]byte(`signature=abc`)))
+}
+
+func TestRedactedJSON(t *testing.T) {
+	data := RedactedJSON(`{"listenKey":"abc","status":"ok"}`)
+	assert.Equal(t, `{"listenKey":"REDACTED","status":"ok"}`, data.String())
+	text, err := json.Marshal(map[string]interface{}{"body": data})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"body":"{\"listenKey\":\"REDACTED\",\"status\":\"ok\"}"}`, string(text))
+
+	var b bytes.Buffer
+	logger := NewStdLogger(log.New(&b, "", 0), LogLevelDebug)
+	logger.Debug("response", "body", RedactedJSON(`{"signature":"abc"}`))
+	assert.Equal(t, "DEBUG response body=\"{\\\"signature\\\":\\\"REDACTED\\\"}\"\n", b.String())
+}
@@ -0,0 +1,353 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const defaultOrderBookRetryDelay = time.Second
+
+// ErrorOrderBookGap is reported when a depth update does not follow the previous one, the book is then resynced
+var ErrorOrderBookGap = errors.New("order book: gap in the depth update sequence")
+
+// OrderBookSequence define how consecutive depth updates are chained
+type OrderBookSequence int
+
+const (
+	// OrderBookSequenceSpot chains updates by FirstUpdateID, which follows the LastUpdateID of the previous update
+	OrderBookSequenceSpot OrderBookSequence = iota
+	// OrderBookSequenceFutures chains updates by PrevLastUpdateID, which equals the LastUpdateID of the previous update
+	OrderBookSequenceFutures
+)
+
+// DepthSnapshot define an order book snapshot fetched from the REST API
+type DepthSnapshot struct {
+	LastUpdateID int64
+	Bids         []PriceLevel
+	Asks         []PriceLevel
+}
+
+// DepthUpdate define a diff of the order book received from the depth stream
+type DepthUpdate struct {
+	FirstUpdateID int64
+	LastUpdateID  int64
+	// PrevLastUpdateID is only used by OrderBookSequenceFutures
+	PrevLastUpdateID int64
+	Bids             []PriceLevel
+	Asks             []PriceLevel
+}
+
+// DepthSnapshotFunc fetch an order book snapshot
+type DepthSnapshotFunc func(ctx context.Context) (*DepthSnapshot, error)
+
+// OrderBookHandler is called after the order book changed
+type OrderBookHandler func(book *OrderBook)
+
+type orderBookLevel struct {
+	price float64
+	level PriceLevel
+}
+
+// OrderBook maintains a local order book from a snapshot and the depth updates.
+// Updates are buffered while the snapshot is fetched, and the book is resynced
+// from a new snapshot when a gap is detected in the update sequence.
+// All the methods are safe for concurrent use.
+type OrderBook struct {
+	// ChangeHandler is called after a snapshot or an update has been applied, it must not block
+	ChangeHandler OrderBookHandler
+	// ErrHandler is called when a snapshot can not be fetched, when a gap triggers a resync
+	// or when the levels of an update can not be parsed, the update is then dropped
+	ErrHandler func(err error)
+	// RetryDelay between two failed snapshot fetches
+	RetryDelay time.Duration
+
+	sequence OrderBookSequence
+	snapshot DepthSnapshotFunc
+
+	mu           sync.RWMutex
+	bids         []orderBookLevel // sorted by descending price
+	asks         []orderBookLevel // sorted by ascending price
+	lastUpdateID int64
+	synced       bool
+	syncing      bool
+	// first is set until the first update following the snapshot has been applied
+	first      bool
+	buffer     []*DepthUpdate
+	generation int64
+	ctx        context.Context
+	cancel     context.CancelFunc
+}
+
+// NewOrderBook init an OrderBook using snapshot to fetch the order book snapshots
+func NewOrderBook(sequence OrderBookSequence, snapshot DepthSnapshotFunc) *OrderBook {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &OrderBook{
+		RetryDelay: defaultOrderBookRetryDelay,
+		sequence:   sequence,
+		snapshot:   snapshot,
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+// Update applies a depth update, the snapshot is fetched in the background when the book is not synced
+func (b *OrderBook) Update(u *DepthUpdate) {
+	b.mu.Lock()
+	if !b.synced {
+		b.buffer = append(b.buffer, u)
+		b.syncLocked()
+		b.mu.Unlock()
+		return
+	}
+	applied, err := b.applyUpdateLocked(u)
+	if err != nil {
+		b.resetLocked()
+		// an update which can not be parsed is dropped
+		if errors.Is(err, ErrorOrderBookGap) {
+			b.buffer = append(b.buffer, u)
+		}
+		b.syncLocked()
+	}
+	b.mu.Unlock()
+	if err != nil {
+		b.handleErr(err)
+	}
+	if applied {
+		b.handleChange()
+	}
+}
+
+// Reset clears the book and cancels the pending snapshot fetch,
+// the book is synced again from the next update
+func (b *OrderBook) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.resetLocked()
+}
+
+// IsSynced returns true when the book is in sync with the depth updates
+func (b *OrderBook) IsSynced() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.synced
+}
+
+// LastUpdateID returns the id of the last update applied to the book
+func (b *OrderBook) LastUpdateID() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.lastUpdateID
+}
+
+// BestBid returns the highest bid, ok is false when there is no bid
+func (b *OrderBook) BestBid() (bid PriceLevel, ok bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.bids) == 0 {
+		return PriceLevel{}, false
+	}
+	return b.bids[0].level, true
+}
+
+// BestAsk returns the lowest ask, ok is false when there is no ask
+func (b *OrderBook) BestAsk() (ask PriceLevel, ok bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.asks) == 0 {
+		return PriceLevel{}, false
+	}
+	return b.asks[0].level, true
+}
+
+// Bids returns the n highest bids, all the bids if n <= 0
+func (b *OrderBook) Bids(n int) []PriceLevel {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return topLevels(b.bids, n)
+}
+
+// Asks returns the n lowest asks, all the asks if n <= 0
+func (b *OrderBook) Asks(n int) []PriceLevel {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return topLevels(b.asks, n)
+}
+
+func topLevels(levels []orderBookLevel, n int) []PriceLevel {
+	if n <= 0 || n > len(levels) {
+		n = len(levels)
+	}
+	res := make([]PriceLevel, n)
+	for i := 0; i < n; i++ {
+		res[i] = levels[i].level
+	}
+	return res
+}
+
+func (b *OrderBook) resetLocked() {
+	b.generation++
+	b.cancel()
+	b.ctx, b.cancel = context.WithCancel(context.Background())
+	b.bids = nil
+	b.asks = nil
+	b.lastUpdateID = 0
+	b.synced = false
+	b.syncing = false
+	b.first = false
+	b.buffer = nil
+}
+
+// syncLocked starts fetching the snapshot unless a fetch is already pending
+func (b *OrderBook) syncLocked() {
+	if b.syncing {
+		return
+	}
+	b.syncing = true
+	go b.sync(b.ctx, b.generation)
+}
+
+func (b *OrderBook) sync(ctx context.Context, generation int64) {
+	for {
+		snapshot, err := b.snapshot(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			b.mu.Lock()
+			if b.generation != generation {
+				b.mu.Unlock()
+				return
+			}
+			var synced bool
+			synced, err = b.applySnapshotLocked(snapshot)
+			b.mu.Unlock()
+			if synced {
+				b.handleChange()
+				return
+			}
+		}
+		b.handleErr(err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(b.RetryDelay):
+		}
+	}
+}
+
+// applySnapshotLocked applies the snapshot and the buffered updates, the book is left unchanged on failure,
+// synced is false when a new snapshot must be fetched
+func (b *OrderBook) applySnapshotLocked(snapshot *DepthSnapshot) (synced bool, err error) {
+	var bids, asks []orderBookLevel
+	if err = applyLevels(&bids, snapshot.Bids, true); err != nil {
+		return false, err
+	}
+	if err = applyLevels(&asks, snapshot.Asks, false); err != nil {
+		return false, err
+	}
+	prevBids, prevAsks, prevLastUpdateID, prevFirst := b.bids, b.asks, b.lastUpdateID, b.first
+	b.bids, b.asks, b.lastUpdateID, b.first = bids, asks, snapshot.LastUpdateID, true
+	for i, u := range b.buffer {
+		if _, err = b.applyUpdateLocked(u); err != nil {
+			b.bids, b.asks, b.lastUpdateID, b.first = prevBids, prevAsks, prevLastUpdateID, prevFirst
+			if errors.Is(err, ErrorOrderBookGap) {
+				// the snapshot is older than the buffered updates or an update is missing,
+				// keep the updates which have not been applied for the next snapshot
+				b.buffer = b.buffer[i:]
+			} else {
+				// the levels of u can not be parsed, u is dropped and the next snapshot must be newer than u
+				b.buffer = b.buffer[i+1:]
+			}
+			return false, err
+		}
+	}
+	b.buffer = nil
+	b.synced = true
+	b.syncing = false
+	return true, nil
+}
+
+// applyUpdateLocked applies u if it follows the last update, applied is false for an update older than the book
+func (b *OrderBook) applyUpdateLocked(u *DepthUpdate) (applied bool, err error) {
+	// the first update following a spot snapshot contains lastUpdateId + 1, a futures one contains lastUpdateId
+	next := b.lastUpdateID + 1
+	if b.sequence == OrderBookSequenceFutures {
+		next = b.lastUpdateID
+	}
+	if b.first {
+		if u.LastUpdateID < next {
+			return false, nil
+		}
+		if u.FirstUpdateID > next {
+			return false, ErrorOrderBookGap
+		}
+	} else {
+		if u.LastUpdateID <= b.lastUpdateID {
+			return false, nil
+		}
+		if b.sequence == OrderBookSequenceFutures && u.PrevLastUpdateID != b.lastUpdateID ||
+			b.sequence == OrderBookSequenceSpot && u.FirstUpdateID != b.lastUpdateID+1 {
+			return false, ErrorOrderBookGap
+		}
+	}
+	if err = applyLevels(&b.bids, u.Bids, true); err != nil {
+		return false, err
+	}
+	if err = applyLevels(&b.asks, u.Asks, false); err != nil {
+		return false, err
+	}
+	b.lastUpdateID = u.LastUpdateID
+	b.first = false
+	return true, nil
+}
+
+// applyLevels sets the quantity of each price level, a level with a zero quantity is removed
+func applyLevels(levels *[]orderBookLevel, updates []PriceLevel, desc bool) error {
+	for _, update := range updates {
+		price, err := strconv.ParseFloat(update.Price, 64)
+		if err != nil {
+			return err
+		}
+		quantity, err := strconv.ParseFloat(update.Quantity, 64)
+		if err != nil {
+			return err
+		}
+		l := *levels
+		i := sort.Search(len(l), func(i int) bool {
+			if desc {
+				return l[i].price <= price
+			}
+			return l[i].price >= price
+		})
+		found := i < len(l) && l[i].price == price
+		switch {
+		case quantity == 0 && found:
+			*levels = append(l[:i], l[i+1:]...)
+		case quantity == 0:
+		case found:
+			l[i].level = update
+		default:
+			l = append(l, orderBookLevel{})
+			copy(l[i+1:], l[i:])
+			l[i] = orderBookLevel{price: price, level: update}
+			*levels = l
+		}
+	}
+	return nil
+}
+
+func (b *OrderBook) handleErr(err error) {
+	if b.ErrHandler != nil {
+		b.ErrHandler(err)
+	}
+}
+
+func (b *OrderBook) handleChange() {
+	if b.ChangeHandler != nil {
+		b.ChangeHandler(b)
+	}
+}
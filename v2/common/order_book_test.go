@@ -0,0 +1,251 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSnapshots returns the snapshots sent to the channel, counting the fetches
+type testSnapshots struct {
+	c     chan *DepthSnapshot
+	mu    sync.Mutex
+	count int
+}
+
+func newTestSnapshots() *testSnapshots {
+	return &testSnapshots{c: make(chan *DepthSnapshot, 10)}
+}
+
+func (s *testSnapshots) fetch(ctx context.Context) (*DepthSnapshot, error) {
+	s.mu.Lock()
+	s.count++
+	s.mu.Unlock()
+	select {
+	case snapshot := <-s.c:
+		if snapshot == nil {
+			return nil, errors.New("snapshot error")
+		}
+		return snapshot, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *testSnapshots) fetches() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.count
+}
+
+func levels(pairs ...string) []PriceLevel {
+	res := make([]PriceLevel, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		res = append(res, PriceLevel{Price: pairs[i], Quantity: pairs[i+1]})
+	}
+	return res
+}
+
+func waitSynced(t *testing.T, b *OrderBook) {
+	require.Eventually(t, b.IsSynced, time.Second, time.Millisecond)
+}
+
+func TestOrderBookSpotSync(t *testing.T) {
+	snapshots := newTestSnapshots()
+	b := NewOrderBook(OrderBookSequenceSpot, snapshots.fetch)
+	changes := make(chan int64, 10)
+	b.ChangeHandler = func(book *OrderBook) {
+		changes <- book.LastUpdateID()
+	}
+
+	// updates are buffered until the snapshot is received
+	b.Update(&DepthUpdate{FirstUpdateID: 95, LastUpdateID: 100, Bids: levels("9", "1")})
+	b.Update(&DepthUpdate{FirstUpdateID: 101, LastUpdateID: 103, Bids: levels("10", "2"), Asks: levels("11", "0")})
+	b.Update(&DepthUpdate{FirstUpdateID: 104, LastUpdateID: 105, Asks: levels("12.5", "4")})
+	assert.False(t, b.IsSynced())
+	_, ok := b.BestBid()
+	assert.False(t, ok)
+
+	snapshots.c <- &DepthSnapshot{
+		LastUpdateID: 102,
+		Bids:         levels("10", "1", "9.5", "3"),
+		Asks:         levels("11", "1", "12", "2"),
+	}
+	waitSynced(t, b)
+	assert.Equal(t, int64(105), <-changes)
+
+	// the update ending at 100 is older than the snapshot and dropped
+	assert.Equal(t, int64(105), b.LastUpdateID())
+	bid, ok := b.BestBid()
+	assert.True(t, ok)
+	assert.Equal(t, PriceLevel{Price: "10", Quantity: "2"}, bid)
+	ask, ok := b.BestAsk()
+	assert.True(t, ok)
+	assert.Equal(t, PriceLevel{Price: "12", Quantity: "2"}, ask)
+	assert.Equal(t, levels("10", "2", "9.5", "3"), b.Bids(0))
+	assert.Equal(t, levels("12", "2", "12.5", "4"), b.Asks(5))
+	assert.Equal(t, levels("12", "2"), b.Asks(1))
+
+	b.Update(&DepthUpdate{FirstUpdateID: 106, LastUpdateID: 107, Bids: levels("10.5", "1", "10", "0")})
+	assert.Equal(t, int64(107), <-changes)
+	assert.Equal(t, levels("10.5", "1", "9.5", "3"), b.Bids(0))
+	assert.Equal(t, 1, snapshots.fetches())
+}
+
+func TestOrderBookSpotGap(t *testing.T) {
+	snapshots := newTestSnapshots()
+	b := NewOrderBook(OrderBookSequenceSpot, snapshots.fetch)
+	var mu sync.Mutex
+	var errs []error
+	b.ErrHandler = func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+	}
+
+	b.Update(&DepthUpdate{FirstUpdateID: 1, LastUpdateID: 10, Bids: levels("1", "1")})
+	snapshots.c <- &DepthSnapshot{LastUpdateID: 5, Bids: levels("1", "2")}
+	waitSynced(t, b)
+	assert.Equal(t, int64(10), b.LastUpdateID())
+
+	// update 11 is missing
+	b.Update(&DepthUpdate{FirstUpdateID: 12, LastUpdateID: 13, Bids: levels("2", "1")})
+	assert.False(t, b.IsSynced())
+	_, ok := b.BestBid()
+	assert.False(t, ok)
+	mu.Lock()
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrorOrderBookGap)
+	mu.Unlock()
+
+	b.Update(&DepthUpdate{FirstUpdateID: 14, LastUpdateID: 15, Bids: levels("3", "1")})
+	snapshots.c <- &DepthSnapshot{LastUpdateID: 13, Bids: levels("1", "1", "2", "1")}
+	waitSynced(t, b)
+	assert.Equal(t, int64(15), b.LastUpdateID())
+	assert.Equal(t, levels("3", "1", "2", "1", "1", "1"), b.Bids(0))
+	assert.Equal(t, 2, snapshots.fetches())
+}
+
+func TestOrderBookSpotStaleSnapshot(t *testing.T) {
+	snapshots := newTestSnapshots()
+	b := NewOrderBook(OrderBookSequenceSpot, snapshots.fetch)
+	b.RetryDelay = time.Millisecond
+
+	b.Update(&DepthUpdate{FirstUpdateID: 20, LastUpdateID: 25, Asks: levels("5", "1")})
+	// the snapshot is older than the first buffered update, a new snapshot is fetched
+	snapshots.c <- &DepthSnapshot{LastUpdateID: 10, Asks: levels("6", "1")}
+	require.Eventually(t, func() bool { return snapshots.fetches() == 2 }, time.Second, time.Millisecond)
+	assert.False(t, b.IsSynced())
+	// the levels of the rejected snapshot are not kept
+	assert.Empty(t, b.Asks(0))
+	assert.Zero(t, b.LastUpdateID())
+
+	snapshots.c <- &DepthSnapshot{LastUpdateID: 22, Asks: levels("6", "1")}
+	waitSynced(t, b)
+	assert.Equal(t, levels("5", "1", "6", "1"), b.Asks(0))
+}
+
+func TestOrderBookMalformedUpdate(t *testing.T) {
+	snapshots := newTestSnapshots()
+	b := NewOrderBook(OrderBookSequenceSpot, snapshots.fetch)
+	b.RetryDelay = time.Millisecond
+	errC := make(chan error, 10)
+	b.ErrHandler = func(err error) {
+		errC <- err
+	}
+
+	b.Update(&DepthUpdate{FirstUpdateID: 11, LastUpdateID: 12, Bids: levels("1", "1")})
+	b.Update(&DepthUpdate{FirstUpdateID: 13, LastUpdateID: 14, Bids: levels("bad", "1")})
+	b.Update(&DepthUpdate{FirstUpdateID: 15, LastUpdateID: 16, Bids: levels("2", "1")})
+	snapshots.c <- &DepthSnapshot{LastUpdateID: 10}
+	err := <-errC
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrorOrderBookGap)
+	assert.Empty(t, b.Bids(0))
+
+	// the malformed update is dropped, the book syncs from a snapshot newer than it
+	snapshots.c <- &DepthSnapshot{LastUpdateID: 12, Bids: levels("1", "1")}
+	assert.ErrorIs(t, <-errC, ErrorOrderBookGap)
+	snapshots.c <- &DepthSnapshot{LastUpdateID: 14, Bids: levels("1", "1")}
+	waitSynced(t, b)
+	assert.Equal(t, int64(16), b.LastUpdateID())
+	assert.Equal(t, levels("2", "1", "1", "1"), b.Bids(0))
+
+	// a malformed update of a synced book is dropped too
+	b.Update(&DepthUpdate{FirstUpdateID: 17, LastUpdateID: 18, Asks: levels("3", "bad")})
+	assert.False(t, b.IsSynced())
+	b.Update(&DepthUpdate{FirstUpdateID: 19, LastUpdateID: 20, Asks: levels("3", "1")})
+	snapshots.c <- &DepthSnapshot{LastUpdateID: 18, Bids: levels("1", "1")}
+	waitSynced(t, b)
+	assert.Equal(t, int64(20), b.LastUpdateID())
+	assert.Equal(t, levels("3", "1"), b.Asks(0))
+}
+
+func TestOrderBookSnapshotError(t *testing.T) {
+	snapshots := newTestSnapshots()
+	b := NewOrderBook(OrderBookSequenceSpot, snapshots.fetch)
+	b.RetryDelay = time.Millisecond
+	errC := make(chan error, 1)
+	b.ErrHandler = func(err error) {
+		errC <- err
+	}
+
+	b.Update(&DepthUpdate{FirstUpdateID: 1, LastUpdateID: 2})
+	snapshots.c <- nil
+	assert.EqualError(t, <-errC, "snapshot error")
+
+	snapshots.c <- &DepthSnapshot{LastUpdateID: 1}
+	waitSynced(t, b)
+	assert.Equal(t, int64(2), b.LastUpdateID())
+}
+
+func TestOrderBookFuturesSync(t *testing.T) {
+	snapshots := newTestSnapshots()
+	b := NewOrderBook(OrderBookSequenceFutures, snapshots.fetch)
+	errC := make(chan error, 1)
+	b.ErrHandler = func(err error) {
+		errC <- err
+	}
+
+	b.Update(&DepthUpdate{FirstUpdateID: 90, LastUpdateID: 99, PrevLastUpdateID: 89})
+	// the first update following the snapshot contains its lastUpdateId
+	b.Update(&DepthUpdate{FirstUpdateID: 100, LastUpdateID: 110, PrevLastUpdateID: 99, Bids: levels("100", "1")})
+	b.Update(&DepthUpdate{FirstUpdateID: 111, LastUpdateID: 120, PrevLastUpdateID: 110, Bids: levels("101", "1")})
+	snapshots.c <- &DepthSnapshot{LastUpdateID: 105}
+	waitSynced(t, b)
+	assert.Equal(t, int64(120), b.LastUpdateID())
+	assert.Equal(t, levels("101", "1", "100", "1"), b.Bids(0))
+
+	// futures updates are chained by pu, U may not follow the previous u
+	b.Update(&DepthUpdate{FirstUpdateID: 125, LastUpdateID: 130, PrevLastUpdateID: 120, Asks: levels("102", "1")})
+	assert.True(t, b.IsSynced())
+	assert.Equal(t, int64(130), b.LastUpdateID())
+
+	b.Update(&DepthUpdate{FirstUpdateID: 135, LastUpdateID: 140, PrevLastUpdateID: 133})
+	assert.False(t, b.IsSynced())
+	assert.ErrorIs(t, <-errC, ErrorOrderBookGap)
+}
+
+func TestOrderBookReset(t *testing.T) {
+	snapshots := newTestSnapshots()
+	b := NewOrderBook(OrderBookSequenceSpot, snapshots.fetch)
+
+	b.Update(&DepthUpdate{FirstUpdateID: 1, LastUpdateID: 2})
+	require.Eventually(t, func() bool { return snapshots.fetches() == 1 }, time.Second, time.Millisecond)
+	// the pending fetch is canceled, the book is synced again from the next update
+	b.Reset()
+	require.Eventually(t, func() bool {
+		b.Update(&DepthUpdate{FirstUpdateID: 3, LastUpdateID: 4})
+		return snapshots.fetches() == 2
+	}, time.Second, time.Millisecond)
+	assert.False(t, b.IsSynced())
+
+	snapshots.c <- &DepthSnapshot{LastUpdateID: 2}
+	waitSynced(t, b)
+	assert.Equal(t, int64(4), b.LastUpdateID())
+}
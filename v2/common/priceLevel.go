@@ -1,6 +1,10 @@
 package common
 
-import "strconv"
+import (
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
 
 // PriceLevel is a common structure for bids and asks in the
 // order book.
@@ -23,3 +27,17 @@ func (p *PriceLevel) Parse() (float64, float64, error) {
 	}
 	return price, quantity, nil
 }
+
+// ParseDecimal is similar to Parse, but it returns decimals
+// so that the precision of the price and quantity is kept.
+func (p *PriceLevel) ParseDecimal() (decimal.Decimal, decimal.Decimal, error) {
+	price, err := decimal.NewFromString(p.Price)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	quantity, err := decimal.NewFromString(p.Quantity)
+	if err != nil {
+		return price, decimal.Zero, err
+	}
+	return price, quantity, nil
+}
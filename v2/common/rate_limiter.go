@@ -0,0 +1,220 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// RateLimitTypeRequestWeight define the REQUEST_WEIGHT rate limit type
+	RateLimitTypeRequestWeight = "REQUEST_WEIGHT"
+	// RateLimitTypeOrders define the ORDERS rate limit type
+	RateLimitTypeOrders = "ORDERS"
+
+	usedWeightHeaderPrefix = "X-MBX-USED-WEIGHT-"
+	orderCountHeaderPrefix = "X-MBX-ORDER-COUNT-"
+)
+
+// RateLimit define a rate limit rule as returned by the exchangeInfo endpoints
+type RateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int64  `json:"intervalNum"`
+	Limit         int64  `json:"limit"`
+}
+
+// RateLimitError is returned by a fail fast RateLimiter when a request would exceed a limit,
+// and by any RateLimiter when the cost of a request is greater than a limit, with a zero RetryAfter
+type RateLimitError struct {
+	RateLimitType string
+	Interval      string
+	Limit         int64
+	RetryAfter    time.Duration
+}
+
+// Error return the exceeded limit and the time until the window resets
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("<RateLimitError> type=%s, interval=%s, limit=%d, retryAfter=%s",
+		e.RateLimitType, e.Interval, e.Limit, e.RetryAfter)
+}
+
+// IsRateLimitError check if e is a RateLimitError
+func IsRateLimitError(e error) bool {
+	_, ok := e.(*RateLimitError)
+	return ok
+}
+
+// rateLimitWindow is a fixed window counter aligned to the interval boundaries, as Binance does
+type rateLimitWindow struct {
+	rateLimitType string
+	key           string // interval key used in the response headers, e.g. 1M, 10S, 1D
+	interval      time.Duration
+	limit         int64
+	used          int64
+	start         time.Time
+}
+
+func (w *rateLimitWindow) roll(now time.Time) {
+	if now.Sub(w.start) >= w.interval {
+		w.start = now.Truncate(w.interval)
+		w.used = 0
+	}
+}
+
+func (w *rateLimitWindow) cost(weight, orders int64) int64 {
+	if w.rateLimitType == RateLimitTypeOrders {
+		return orders
+	}
+	return weight
+}
+
+// RateLimiter keeps track of the REQUEST_WEIGHT and ORDERS limits of an account/IP.
+// It reserves the cost of a request before it is sent and resyncs its counters
+// from the X-MBX-USED-WEIGHT-* and X-MBX-ORDER-COUNT-* headers of every response.
+type RateLimiter struct {
+	// FailFast makes Wait return a *RateLimitError instead of blocking until the window resets
+	FailFast bool
+
+	mu      sync.Mutex
+	windows []*rateLimitWindow
+	now     func() time.Time
+}
+
+// NewRateLimiter init a RateLimiter seeded with the given rate limits.
+// Rate limits of other types than REQUEST_WEIGHT and ORDERS are ignored.
+func NewRateLimiter(rateLimits ...RateLimit) *RateLimiter {
+	l := &RateLimiter{now: time.Now}
+	for _, rl := range rateLimits {
+		if rl.RateLimitType != RateLimitTypeRequestWeight && rl.RateLimitType != RateLimitTypeOrders {
+			continue
+		}
+		interval := rateLimitIntervalDuration(rl.Interval, rl.IntervalNum)
+		if interval <= 0 || rl.Limit <= 0 {
+			continue
+		}
+		l.windows = append(l.windows, &rateLimitWindow{
+			rateLimitType: rl.RateLimitType,
+			key:           rateLimitIntervalKey(rl.Interval, rl.IntervalNum),
+			interval:      interval,
+			limit:         rl.Limit,
+		})
+	}
+	return l
+}
+
+func rateLimitIntervalDuration(interval string, num int64) time.Duration {
+	var unit time.Duration
+	switch strings.ToUpper(interval) {
+	case "SECOND":
+		unit = time.Second
+	case "MINUTE":
+		unit = time.Minute
+	case "HOUR":
+		unit = time.Hour
+	case "DAY":
+		unit = 24 * time.Hour
+	default:
+		return 0
+	}
+	if num <= 0 {
+		num = 1
+	}
+	return time.Duration(num) * unit
+}
+
+func rateLimitIntervalKey(interval string, num int64) string {
+	if num <= 0 {
+		num = 1
+	}
+	return fmt.Sprintf("%d%s", num, strings.ToUpper(interval[:1]))
+}
+
+// Wait reserves weight and orders in every window, blocking until enough capacity is
+// available or ctx is done. If FailFast is set, a *RateLimitError is returned instead of blocking.
+// A *RateLimitError is returned immediately if the cost is greater than a limit.
+func (l *RateLimiter) Wait(ctx context.Context, weight, orders int64) error {
+	for {
+		l.mu.Lock()
+		now := l.now()
+		var exceeded *rateLimitWindow
+		var wait time.Duration
+		for _, w := range l.windows {
+			w.roll(now)
+			cost := w.cost(weight, orders)
+			if cost == 0 || w.used+cost <= w.limit {
+				continue
+			}
+			// the request would never fit in the window, even after it resets
+			if cost > w.limit {
+				l.mu.Unlock()
+				return &RateLimitError{
+					RateLimitType: w.rateLimitType,
+					Interval:      w.key,
+					Limit:         w.limit,
+				}
+			}
+			if d := w.start.Add(w.interval).Sub(now); exceeded == nil || d > wait {
+				exceeded, wait = w, d
+			}
+		}
+		if exceeded == nil {
+			for _, w := range l.windows {
+				w.used += w.cost(weight, orders)
+			}
+			l.mu.Unlock()
+			return nil
+		}
+		l.mu.Unlock()
+
+		if l.FailFast {
+			return &RateLimitError{
+				RateLimitType: exceeded.rateLimitType,
+				Interval:      exceeded.key,
+				Limit:         exceeded.limit,
+				RetryAfter:    wait,
+			}
+		}
+		if err := Sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// Update resyncs the counters from the used weight and order count headers of a response
+func (l *RateLimiter) Update(header http.Header) {
+	if l == nil || header == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	usedWeight, orderCount := ParseUsedLimits(header)
+	for _, w := range l.windows {
+		used, ok := usedWeight[w.key]
+		if w.rateLimitType == RateLimitTypeOrders {
+			used, ok = orderCount[w.key]
+		}
+		if ok {
+			w.roll(now)
+			w.used = used
+		}
+	}
+}
+
+// Used return the used weight or order count of the given type and interval key (e.g. 1M, 10S, 1D)
+func (l *RateLimiter) Used(rateLimitType, key string) (used, limit int64, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	for _, w := range l.windows {
+		if w.rateLimitType == rateLimitType && w.key == strings.ToUpper(key) {
+			w.roll(now)
+			return w.used, w.limit, true
+		}
+	}
+	return 0, 0, false
+}
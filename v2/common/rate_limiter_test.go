@@ -0,0 +1,110 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRateLimiter(now time.Time) *RateLimiter {
+	l := NewRateLimiter(
+		RateLimit{RateLimitType: RateLimitTypeRequestWeight, Interval: "MINUTE", IntervalNum: 1, Limit: 10},
+		RateLimit{RateLimitType: RateLimitTypeOrders, Interval: "SECOND", IntervalNum: 10, Limit: 2},
+		RateLimit{RateLimitType: "RAW_REQUESTS", Interval: "MINUTE", IntervalNum: 5, Limit: 100},
+	)
+	l.now = func() time.Time { return now }
+	return l
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)
+	l := newTestRateLimiter(now)
+	l.FailFast = true
+	ctx := context.Background()
+
+	require.NoError(t, l.Wait(ctx, 5, 1))
+	require.NoError(t, l.Wait(ctx, 5, 1))
+
+	used, limit, ok := l.Used(RateLimitTypeRequestWeight, "1m")
+	assert.True(t, ok)
+	assert.Equal(t, int64(10), used)
+	assert.Equal(t, int64(10), limit)
+
+	err := l.Wait(ctx, 1, 0)
+	require.Error(t, err)
+	assert.True(t, IsRateLimitError(err))
+	rlErr := err.(*RateLimitError)
+	assert.Equal(t, RateLimitTypeRequestWeight, rlErr.RateLimitType)
+	assert.Equal(t, "1M", rlErr.Interval)
+	assert.Equal(t, 30*time.Second, rlErr.RetryAfter)
+
+	_, _, ok = l.Used("RAW_REQUESTS", "5M")
+	assert.False(t, ok)
+}
+
+func TestRateLimiterRollWindow(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 59, 0, time.UTC)
+	l := newTestRateLimiter(now)
+	l.now = func() time.Time { return now }
+	l.FailFast = true
+	ctx := context.Background()
+
+	require.NoError(t, l.Wait(ctx, 10, 0))
+	require.Error(t, l.Wait(ctx, 1, 0))
+
+	now = now.Add(time.Second)
+	require.NoError(t, l.Wait(ctx, 1, 0))
+	used, _, _ := l.Used(RateLimitTypeRequestWeight, "1M")
+	assert.Equal(t, int64(1), used)
+}
+
+func TestRateLimiterBlockUntilContextDone(t *testing.T) {
+	l := newTestRateLimiter(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	require.NoError(t, l.Wait(ctx, 0, 2))
+	assert.Equal(t, context.DeadlineExceeded, l.Wait(ctx, 0, 1))
+}
+
+func TestRateLimiterCostGreaterThanLimit(t *testing.T) {
+	l := newTestRateLimiter(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// FailFast is not set, the request is rejected without waiting
+	err := l.Wait(ctx, 11, 0)
+	require.Error(t, err)
+	assert.True(t, IsRateLimitError(err))
+	rlErr := err.(*RateLimitError)
+	assert.Equal(t, RateLimitTypeRequestWeight, rlErr.RateLimitType)
+	assert.Equal(t, int64(10), rlErr.Limit)
+	assert.Zero(t, rlErr.RetryAfter)
+	assert.NoError(t, ctx.Err())
+
+	used, _, _ := l.Used(RateLimitTypeRequestWeight, "1M")
+	assert.Zero(t, used)
+}
+
+func TestRateLimiterUpdate(t *testing.T) {
+	l := newTestRateLimiter(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	header := http.Header{}
+	header.Set("X-Mbx-Used-Weight", "7")
+	header.Set("X-Mbx-Used-Weight-1m", "8")
+	header.Set("X-Mbx-Order-Count-10s", "2")
+	header.Set("X-Mbx-Order-Count-1d", "20")
+	l.Update(header)
+
+	used, _, _ := l.Used(RateLimitTypeRequestWeight, "1M")
+	assert.Equal(t, int64(8), used)
+	used, _, _ = l.Used(RateLimitTypeOrders, "10S")
+	assert.Equal(t, int64(2), used)
+
+	var nilLimiter *RateLimiter
+	nilLimiter.Update(header)
+}
@@ -0,0 +1,134 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jpillora/backoff"
+)
+
+// DefaultRetryableCodes define the error codes of requests which were rejected before being processed,
+// so they can be retried safely even if the request is not idempotent
+var DefaultRetryableCodes = []int64{
+	int64(ErrorCodeDisconnected),
+	int64(ErrorCodeTooManyRequests),
+	int64(ErrorCodeServerBusy),
+	int64(ErrorCodeTooManyOrders),
+	int64(ErrorCodeInvalidTimestamp),
+}
+
+// RetryPolicy define when and how often a failed REST request is retried.
+// GET requests are retried on network errors, 429, 418 and 5xx responses.
+// Other requests are only retried on 429 and 418 responses or if the error code is one of RetryableCodes.
+// Signed requests get a new timestamp and signature on every attempt.
+type RetryPolicy struct {
+	// MaxRetries is the max number of retries after the first attempt
+	MaxRetries int
+	// MinBackoff and MaxBackoff bound the exponential backoff between two attempts
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	Factor     float64
+	Jitter     bool
+	// MaxRetryAfter is the max delay requested by a Retry-After header which is waited for,
+	// longer delays (e.g. an IP ban) are returned to the caller. Zero means no max.
+	MaxRetryAfter time.Duration
+	// RetryableCodes define the error codes for which non-idempotent requests are retried
+	RetryableCodes []int64
+}
+
+// NewRetryPolicy init a RetryPolicy with the default settings
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:     3,
+		MinBackoff:     100 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Factor:         2,
+		Jitter:         true,
+		MaxRetryAfter:  time.Minute,
+		RetryableCodes: DefaultRetryableCodes,
+	}
+}
+
+func (p *RetryPolicy) isRetryableCode(code int64) bool {
+	for _, c := range p.RetryableCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Retry return the delay before the next attempt, or false if the request should not be retried.
+// attempt is the zero based number of the attempt that failed, res is nil on network errors.
+func (p *RetryPolicy) Retry(attempt int, method string, res *http.Response, err error) (time.Duration, bool) {
+	if p == nil || err == nil || attempt >= p.MaxRetries {
+		return 0, false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || IsRateLimitError(err) {
+		return 0, false
+	}
+	idempotent := method == http.MethodGet || method == http.MethodHead
+	var apiErr *APIError
+	isAPIErr := errors.As(err, &apiErr)
+
+	var retryable bool
+	switch {
+	case res == nil:
+		retryable = idempotent
+	case res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusTeapot:
+		retryable = true
+	case res.StatusCode >= http.StatusInternalServerError:
+		retryable = idempotent || (isAPIErr && p.isRetryableCode(apiErr.Code))
+	default:
+		retryable = isAPIErr && p.isRetryableCode(apiErr.Code)
+	}
+	if !retryable {
+		return 0, false
+	}
+
+	b := &backoff.Backoff{
+		Min:    p.MinBackoff,
+		Max:    p.MaxBackoff,
+		Factor: p.Factor,
+		Jitter: p.Jitter,
+	}
+	delay := b.ForAttempt(float64(attempt))
+	if res != nil {
+		if retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			if p.MaxRetryAfter > 0 && retryAfter > p.MaxRetryAfter {
+				return 0, false
+			}
+			if retryAfter > delay {
+				delay = retryAfter
+			}
+		}
+	}
+	return delay, true
+}
+
+// parseRetryAfter parse a Retry-After header given in seconds
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	seconds, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// Sleep wait for d or until ctx is done
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
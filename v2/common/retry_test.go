@@ -0,0 +1,78 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRetryPolicy() *RetryPolicy {
+	p := NewRetryPolicy()
+	p.Jitter = false
+	return p
+}
+
+func newTestResponse(statusCode int, retryAfter string) *http.Response {
+	res := &http.Response{StatusCode: statusCode, Header: http.Header{}}
+	if retryAfter != "" {
+		res.Header.Set("Retry-After", retryAfter)
+	}
+	return res
+}
+
+func TestRetryPolicyRetry(t *testing.T) {
+	p := newTestRetryPolicy()
+	networkErr := errors.New("connection reset by peer")
+	tests := []struct {
+		name   string
+		method string
+		res    *http.Response
+		err    error
+		retry  bool
+	}{
+		{"network error on GET", http.MethodGet, nil, networkErr, true},
+		{"network error on POST", http.MethodPost, nil, networkErr, false},
+		{"too many requests on POST", http.MethodPost, newTestResponse(http.StatusTooManyRequests, ""), &APIError{Code: -1003}, true},
+		{"server error on GET", http.MethodGet, newTestResponse(http.StatusServiceUnavailable, ""), &APIError{Code: -1000}, true},
+		{"server error on POST", http.MethodPost, newTestResponse(http.StatusServiceUnavailable, ""), &APIError{Code: -1007}, false},
+		{"disconnected on POST", http.MethodPost, newTestResponse(http.StatusInternalServerError, ""), &APIError{Code: -1001}, true},
+		{"timestamp on POST", http.MethodPost, newTestResponse(http.StatusBadRequest, ""), &APIError{Code: -1021}, true},
+		{"invalid symbol on GET", http.MethodGet, newTestResponse(http.StatusBadRequest, ""), &APIError{Code: -1121}, false},
+		{"context canceled", http.MethodGet, nil, context.Canceled, false},
+		{"rate limiter", http.MethodGet, nil, &RateLimitError{}, false},
+	}
+	for _, test := range tests {
+		_, retry := p.Retry(0, test.method, test.res, test.err)
+		assert.Equal(t, test.retry, retry, test.name)
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	p := newTestRetryPolicy()
+	res := newTestResponse(http.StatusBadGateway, "")
+	err := &APIError{Code: -1000}
+
+	delay, ok := p.Retry(0, http.MethodGet, res, err)
+	assert.True(t, ok)
+	assert.Equal(t, 100*time.Millisecond, delay)
+	delay, ok = p.Retry(2, http.MethodGet, res, err)
+	assert.True(t, ok)
+	assert.Equal(t, 400*time.Millisecond, delay)
+	_, ok = p.Retry(3, http.MethodGet, res, err)
+	assert.False(t, ok)
+
+	delay, ok = p.Retry(0, http.MethodGet, newTestResponse(http.StatusTooManyRequests, "2"), err)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, delay)
+
+	_, ok = p.Retry(0, http.MethodGet, newTestResponse(http.StatusTeapot, "3600"), err)
+	assert.False(t, ok)
+
+	var nilPolicy *RetryPolicy
+	_, ok = nilPolicy.Retry(0, http.MethodGet, res, err)
+	assert.False(t, ok)
+}
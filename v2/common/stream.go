@@ -0,0 +1,106 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrorStreamOverflow is sent on the error channel of a stream stopped by OverflowPolicyError
+var ErrorStreamOverflow = errors.New("stream: events buffer is full")
+
+// OverflowPolicy define what a stream does with an event when its events channel is full
+type OverflowPolicy string
+
+const (
+	// OverflowPolicyBlock waits for the event to be received, the websocket is not read meanwhile
+	OverflowPolicyBlock OverflowPolicy = "BLOCK"
+	// OverflowPolicyDropOldest drops the oldest buffered event to make room for the new one
+	OverflowPolicyDropOldest OverflowPolicy = "DROP_OLDEST"
+	// OverflowPolicyError stops the stream with ErrorStreamOverflow
+	OverflowPolicyError OverflowPolicy = "ERROR"
+)
+
+// StreamOptions define the buffering of a stream
+type StreamOptions struct {
+	// BufferSize is the capacity of the events and errors channels
+	BufferSize     int
+	OverflowPolicy OverflowPolicy
+}
+
+// NewStreamOptions init StreamOptions with the default settings
+func NewStreamOptions() *StreamOptions {
+	return &StreamOptions{
+		BufferSize:     256,
+		OverflowPolicy: OverflowPolicyBlock,
+	}
+}
+
+// Stream runs serve until ctx is done and sends its events to the returned events channel.
+// The errors are sent to the errors channel, they are dropped when it is full.
+// Both channels are closed once the connection is closed, either because ctx is done or because it is lost.
+// serve is usually a closure calling one of the Ws*Serve functions, opts may be nil to use the default settings.
+func Stream[E any](ctx context.Context, serve func(handler func(event E), errHandler func(err error)) (doneC, stopC chan struct{}, err error), opts *StreamOptions) (<-chan E, <-chan error, error) {
+	if opts == nil {
+		opts = NewStreamOptions()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	eventC := make(chan E, opts.BufferSize)
+	errC := make(chan error, opts.BufferSize)
+	sendErr := func(err error) {
+		select {
+		case errC <- err:
+		default:
+		}
+	}
+	// overflowed makes sure ErrorStreamOverflow is sent once
+	var overflowed sync.Once
+	handler := func(event E) {
+		switch opts.OverflowPolicy {
+		case OverflowPolicyDropOldest:
+			for {
+				select {
+				case eventC <- event:
+					return
+				default:
+				}
+				select {
+				case <-eventC:
+				default:
+				}
+			}
+		case OverflowPolicyError:
+			select {
+			case eventC <- event:
+			default:
+				overflowed.Do(func() {
+					sendErr(ErrorStreamOverflow)
+					cancel()
+				})
+			}
+		default:
+			select {
+			case eventC <- event:
+			case <-ctx.Done():
+			}
+		}
+	}
+	doneC, stopC, err := serve(handler, sendErr)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	go func() {
+		defer cancel()
+		select {
+		case <-ctx.Done():
+			close(stopC)
+			<-doneC
+		case <-doneC:
+		}
+		// the handlers are no longer called once doneC is closed
+		close(eventC)
+		close(errC)
+	}()
+	return eventC, errC, nil
+}
@@ -0,0 +1,490 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/jpillora/backoff"
+)
+
+const (
+	// MaxStreamsPerConnection is the max number of streams a connection can subscribe to
+	MaxStreamsPerConnection = 1024
+	// MaxStreamMessagesPerSecond is the max number of messages a connection can send per second
+	MaxStreamMessagesPerSecond = 5
+
+	streamMethodSubscribe         = "SUBSCRIBE"
+	streamMethodUnsubscribe       = "UNSUBSCRIBE"
+	streamMethodListSubscriptions = "LIST_SUBSCRIPTIONS"
+)
+
+var (
+	// ErrorStreamClientClosed is returned by the calls made after the StreamClient has been closed
+	ErrorStreamClientClosed = errors.New("stream client: closed")
+	// ErrorStreamClientConnected is returned by Connect when the StreamClient is already connected
+	ErrorStreamClientConnected = errors.New("stream client: already connected")
+	// ErrorTooManyStreams is returned when a subscription would exceed MaxStreamsPerConnection
+	ErrorTooManyStreams = errors.New("stream client: too many streams")
+)
+
+// StreamHandler handle the data of a stream message
+type StreamHandler func(stream string, data []byte) error
+
+type streamRequest struct {
+	Method string   `json:"method"`
+	Params []string `json:"params,omitempty"`
+	ID     int64    `json:"id"`
+}
+
+// streamMessage is either a stream payload or the response of a request
+type streamMessage struct {
+	Stream string          `json:"stream"`
+	Data   json.RawMessage `json:"data"`
+	ID     *int64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *APIError       `json:"error"`
+}
+
+// streamCall is a request waiting for its response on conn
+type streamCall struct {
+	conn      *websocket.Conn
+	responseC chan *streamResponse
+	// onSuccess is called with the client locked when the request succeeded, before the next message is read
+	onSuccess func()
+}
+
+type streamResponse struct {
+	result json.RawMessage
+	err    error
+}
+
+// StreamClient subscribes to streams at runtime on a single combined stream connection.
+// The payloads are routed to the handler of their stream, the subscriptions are restored after a reconnection.
+type StreamClient struct {
+	// ErrHandler is called for connection errors and the errors returned by the handlers
+	ErrHandler func(err error)
+	// ReconnectPolicy define how the connection is restored when it is lost, it is not restored if nil.
+	// Its StateHandler is notified of the connection state changes.
+	ReconnectPolicy *WsReconnectPolicy
+
+	endpoint string
+	proxy    *string
+
+	// sendMu serializes the requests and keeps them MaxStreamMessagesPerSecond apart
+	sendMu   sync.Mutex
+	lastSent time.Time
+
+	mu       sync.Mutex
+	conn     *websocket.Conn
+	handlers map[string]StreamHandler
+	pending  map[int64]streamCall
+	nextID   int64
+	started  bool
+	closed   bool
+	closeC   chan struct{}
+	doneC    chan struct{}
+}
+
+// NewStreamClient init a StreamClient of the combined stream endpoint, e.g. wss://stream.binance.com:9443/stream
+func NewStreamClient(endpoint string, proxy *string) *StreamClient {
+	return &StreamClient{
+		ReconnectPolicy: NewWsReconnectPolicy(),
+		endpoint:        endpoint,
+		proxy:           proxy,
+		handlers:        make(map[string]StreamHandler),
+		pending:         make(map[int64]streamCall),
+		closeC:          make(chan struct{}),
+		doneC:           make(chan struct{}),
+	}
+}
+
+// Connect opens the connection, it must be called once before subscribing.
+// ErrorStreamClientConnected is returned if it has already been called, ErrorStreamClientClosed after Close.
+func (c *StreamClient) Connect() error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return ErrorStreamClientClosed
+	}
+	if c.started {
+		c.mu.Unlock()
+		return ErrorStreamClientConnected
+	}
+	c.started = true
+	c.mu.Unlock()
+
+	conn, err := c.dial()
+	c.mu.Lock()
+	if err != nil {
+		c.started = false
+		c.mu.Unlock()
+		return err
+	}
+	if c.closed {
+		// closed while dialing, doneC has been closed by Close
+		c.mu.Unlock()
+		conn.Close()
+		return ErrorStreamClientClosed
+	}
+	c.conn = conn
+	c.mu.Unlock()
+	c.handleState(&WsStateEvent{State: WsStateConnected, Endpoint: c.endpoint})
+	go c.run(conn)
+	return nil
+}
+
+// Done returns a channel closed once the client is closed or the connection can not be restored
+func (c *StreamClient) Done() <-chan struct{} {
+	return c.doneC
+}
+
+// Close unsubscribes from all the streams by closing the connection
+func (c *StreamClient) Close() error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	close(c.closeC)
+	conn := c.conn
+	c.mu.Unlock()
+	if conn == nil {
+		close(c.doneC)
+		return nil
+	}
+	err := conn.Close()
+	<-c.doneC
+	return err
+}
+
+// Subscribe subscribes to the streams, their payloads are passed to handler
+func (c *StreamClient) Subscribe(ctx context.Context, handler StreamHandler, streams ...string) error {
+	c.mu.Lock()
+	var added []string
+	for _, stream := range streams {
+		if _, ok := c.handlers[stream]; !ok {
+			added = append(added, stream)
+		}
+	}
+	if len(c.handlers)+len(added) > MaxStreamsPerConnection {
+		c.mu.Unlock()
+		return ErrorTooManyStreams
+	}
+	c.mu.Unlock()
+
+	// the handlers are set once the subscription succeeded, before the following payloads are read
+	_, err := c.call(ctx, streamMethodSubscribe, streams, func() {
+		for _, stream := range streams {
+			c.handlers[stream] = handler
+		}
+	})
+	return err
+}
+
+// Unsubscribe unsubscribes from the streams
+func (c *StreamClient) Unsubscribe(ctx context.Context, streams ...string) error {
+	if _, err := c.call(ctx, streamMethodUnsubscribe, streams, nil); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, stream := range streams {
+		delete(c.handlers, stream)
+	}
+	return nil
+}
+
+// ListSubscriptions returns the streams subscribed to, as listed by the server
+func (c *StreamClient) ListSubscriptions(ctx context.Context) ([]string, error) {
+	result, err := c.call(ctx, streamMethodListSubscriptions, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	streams := make([]string, 0)
+	if err = json.Unmarshal(result, &streams); err != nil {
+		return nil, err
+	}
+	return streams, nil
+}
+
+// Streams returns the streams which have a handler, sorted by name
+func (c *StreamClient) Streams() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	streams := make([]string, 0, len(c.handlers))
+	for stream := range c.handlers {
+		streams = append(streams, stream)
+	}
+	sort.Strings(streams)
+	return streams
+}
+
+func (c *StreamClient) call(ctx context.Context, method string, params []string, onSuccess func()) (json.RawMessage, error) {
+	id, responseC, err := c.send(ctx, method, params, onSuccess)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+	}()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-responseC:
+		return res.result, res.err
+	}
+}
+
+// send writes a request on the current connection, its response is delivered into responseC
+func (c *StreamClient) send(ctx context.Context, method string, params []string, onSuccess func()) (id int64, responseC chan *streamResponse, err error) {
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+	if err = Sleep(ctx, time.Until(c.lastSent.Add(time.Second/MaxStreamMessagesPerSecond))); err != nil {
+		return 0, nil, err
+	}
+
+	c.mu.Lock()
+	if c.closed || c.conn == nil {
+		c.mu.Unlock()
+		return 0, nil, ErrorStreamClientClosed
+	}
+	c.nextID++
+	req := &streamRequest{Method: method, Params: params, ID: c.nextID}
+	responseC = make(chan *streamResponse, 1)
+	conn := c.conn
+	c.pending[req.ID] = streamCall{conn: conn, responseC: responseC, onSuccess: onSuccess}
+	c.mu.Unlock()
+
+	data, err := json.Marshal(req)
+	if err == nil {
+		c.lastSent = time.Now()
+		err = conn.WriteMessage(websocket.TextMessage, data)
+	}
+	if err != nil {
+		c.mu.Lock()
+		delete(c.pending, req.ID)
+		c.mu.Unlock()
+		return 0, nil, err
+	}
+	return req.ID, responseC, nil
+}
+
+func (c *StreamClient) dial() (*websocket.Conn, error) {
+	proxy := http.ProxyFromEnvironment
+	if c.proxy != nil {
+		u, err := url.Parse(*c.proxy)
+		if err != nil {
+			return nil, err
+		}
+		proxy = http.ProxyURL(u)
+	}
+	dialer := websocket.Dialer{
+		Proxy:            proxy,
+		HandshakeTimeout: 45 * time.Second,
+	}
+	conn, _, err := dialer.Dial(c.endpoint, nil)
+	return conn, err
+}
+
+// run reads the messages of conn and restores the connection when it is lost
+func (c *StreamClient) run(conn *websocket.Conn) {
+	defer close(c.doneC)
+	for {
+		err := c.read(conn)
+		c.failPending(conn, err)
+		c.mu.Lock()
+		closed, current := c.closed, c.conn
+		c.mu.Unlock()
+		if closed {
+			if current != conn {
+				c.failPending(current, ErrorStreamClientClosed)
+			}
+			return
+		}
+		if current != conn {
+			// conn has been replaced by rotate
+			conn = current
+			continue
+		}
+		c.handleErr(err)
+		c.handleState(&WsStateEvent{State: WsStateDisconnected, Endpoint: c.endpoint, Err: err})
+		var ok bool
+		if conn, ok = c.reconnect(); !ok {
+			return
+		}
+		go c.resubscribe()
+	}
+}
+
+func (c *StreamClient) read(conn *websocket.Conn) error {
+	if p := c.ReconnectPolicy; p != nil && p.MaxConnectionAge > 0 {
+		// the connection is replaced before the server closes it
+		timer := time.AfterFunc(p.MaxConnectionAge, func() {
+			c.rotate(conn)
+		})
+		defer timer.Stop()
+	}
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+		c.handleMessage(message)
+	}
+}
+
+func (c *StreamClient) handleMessage(message []byte) {
+	msg := new(streamMessage)
+	if err := json.Unmarshal(message, msg); err != nil {
+		c.handleErr(err)
+		return
+	}
+	if msg.Stream == "" {
+		if msg.ID == nil {
+			return
+		}
+		res := &streamResponse{result: msg.Result}
+		if msg.Error != nil {
+			res.err = msg.Error
+		}
+		c.mu.Lock()
+		call, ok := c.pending[*msg.ID]
+		delete(c.pending, *msg.ID)
+		if ok && res.err == nil && call.onSuccess != nil {
+			call.onSuccess()
+		}
+		c.mu.Unlock()
+		if ok {
+			call.responseC <- res
+		}
+		return
+	}
+	c.mu.Lock()
+	handler, ok := c.handlers[msg.Stream]
+	c.mu.Unlock()
+	if !ok {
+		return
+	}
+	if err := handler(msg.Stream, msg.Data); err != nil {
+		c.handleErr(err)
+	}
+}
+
+// failPending fails the requests sent on conn with err
+func (c *StreamClient) failPending(conn *websocket.Conn, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for id, call := range c.pending {
+		if call.conn == conn {
+			call.responseC <- &streamResponse{err: err}
+			delete(c.pending, id)
+		}
+	}
+}
+
+// rotate replaces conn by a new connection once it reached the MaxConnectionAge of the ReconnectPolicy.
+// The new connection is dialed and the streams are subscribed on it before conn is closed,
+// conn is kept until it is lost if the new connection can not be dialed.
+func (c *StreamClient) rotate(conn *websocket.Conn) {
+	newConn, err := c.dial()
+	if err != nil {
+		c.handleErr(err)
+		return
+	}
+	c.mu.Lock()
+	if c.closed || c.conn != conn {
+		c.mu.Unlock()
+		newConn.Close()
+		return
+	}
+	c.conn = newConn
+	c.mu.Unlock()
+
+	// the response is read once run switches to the new connection
+	if streams := c.Streams(); len(streams) > 0 {
+		_, responseC, err := c.send(context.Background(), streamMethodSubscribe, streams, nil)
+		if err != nil {
+			c.handleErr(err)
+		} else {
+			go func() {
+				if res := <-responseC; res.err != nil {
+					c.handleErr(res.err)
+				}
+			}()
+		}
+	}
+	conn.Close()
+}
+
+// reconnect dials with an exponential backoff, ok is false when the client is closed or MaxRetries is reached
+func (c *StreamClient) reconnect() (conn *websocket.Conn, ok bool) {
+	p := c.ReconnectPolicy
+	if p == nil {
+		return nil, false
+	}
+	b := &backoff.Backoff{
+		Min:    p.MinBackoff,
+		Max:    p.MaxBackoff,
+		Factor: p.Factor,
+		Jitter: p.Jitter,
+	}
+	for attempt := 1; p.MaxRetries <= 0 || attempt <= p.MaxRetries; attempt++ {
+		c.handleState(&WsStateEvent{State: WsStateReconnecting, Endpoint: c.endpoint, Attempt: attempt})
+		timer := time.NewTimer(b.Duration())
+		select {
+		case <-c.closeC:
+			timer.Stop()
+			return nil, false
+		case <-timer.C:
+		}
+		conn, err := c.dial()
+		if err != nil {
+			c.handleErr(err)
+			continue
+		}
+		c.mu.Lock()
+		if c.closed {
+			c.mu.Unlock()
+			conn.Close()
+			return nil, false
+		}
+		c.conn = conn
+		c.mu.Unlock()
+		c.handleState(&WsStateEvent{State: WsStateConnected, Endpoint: c.endpoint, Attempt: attempt})
+		return conn, true
+	}
+	return nil, false
+}
+
+// resubscribe subscribes again to the streams after a reconnection
+func (c *StreamClient) resubscribe() {
+	streams := c.Streams()
+	if len(streams) == 0 {
+		return
+	}
+	if _, err := c.call(context.Background(), streamMethodSubscribe, streams, nil); err != nil {
+		c.handleErr(err)
+	}
+}
+
+func (c *StreamClient) handleErr(err error) {
+	if c.ErrHandler != nil {
+		c.ErrHandler(err)
+	}
+}
+
+func (c *StreamClient) handleState(event *WsStateEvent) {
+	if c.ReconnectPolicy != nil && c.ReconnectPolicy.StateHandler != nil {
+		c.ReconnectPolicy.StateHandler(event)
+	}
+}
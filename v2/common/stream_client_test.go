@@ -0,0 +1,275 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testStreamServer implements the subscriptions of the combined stream endpoint
+type testStreamServer struct {
+	mu      sync.Mutex
+	streams map[string]bool
+	conns   chan *testStreamConn
+}
+
+// testStreamConn serializes the writes of the server and of the test
+type testStreamConn struct {
+	*websocket.Conn
+	mu sync.Mutex
+}
+
+func (c *testStreamConn) push(message string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.WriteMessage(websocket.TextMessage, []byte(message))
+}
+
+func newTestStreamServer(t *testing.T) (*testStreamServer, string) {
+	s := &testStreamServer{
+		streams: make(map[string]bool),
+		conns:   make(chan *testStreamConn, 10),
+	}
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		c := &testStreamConn{Conn: conn}
+		s.mu.Lock()
+		s.streams = make(map[string]bool)
+		s.mu.Unlock()
+		s.conns <- c
+		for {
+			_, message, err := c.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err = c.push(string(s.handle(message))); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	return s, "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func (s *testStreamServer) handle(message []byte) []byte {
+	req := new(streamRequest)
+	if err := json.Unmarshal(message, req); err != nil {
+		return []byte(`{"error":{"code":3,"msg":"Invalid JSON"},"id":null}`)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := []byte("null")
+	switch req.Method {
+	case streamMethodSubscribe:
+		for _, stream := range req.Params {
+			if stream == "invalid" {
+				return []byte(fmt.Sprintf(`{"error":{"code":2,"msg":"Invalid request"},"id":%d}`, req.ID))
+			}
+			s.streams[stream] = true
+		}
+	case streamMethodUnsubscribe:
+		for _, stream := range req.Params {
+			delete(s.streams, stream)
+		}
+	case streamMethodListSubscriptions:
+		streams := make([]string, 0, len(s.streams))
+		for stream := range s.streams {
+			streams = append(streams, stream)
+		}
+		result, _ = json.Marshal(streams)
+	}
+	return []byte(fmt.Sprintf(`{"result":%s,"id":%d}`, result, req.ID))
+}
+
+func (s *testStreamServer) subscribed(stream string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.streams[stream]
+}
+
+func TestStreamClient(t *testing.T) {
+	server, endpoint := newTestStreamServer(t)
+	c := NewStreamClient(endpoint, nil)
+	require.NoError(t, c.Connect())
+	defer c.Close()
+	conn := <-server.conns
+
+	messages := make(chan string, 10)
+	handler := func(stream string, data []byte) error {
+		messages <- stream + " " + string(data)
+		return nil
+	}
+	ctx := context.Background()
+	require.NoError(t, c.Subscribe(ctx, handler, "btcusdt@trade", "btcusdt@bookTicker"))
+	assert.Equal(t, []string{"btcusdt@bookTicker", "btcusdt@trade"}, c.Streams())
+	streams, err := c.ListSubscriptions(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"btcusdt@bookTicker", "btcusdt@trade"}, streams)
+
+	// the payloads are routed by stream, the unknown streams are ignored
+	require.NoError(t, conn.push(`{"stream":"ethusdt@trade","data":{"e":"trade"}}`))
+	require.NoError(t, conn.push(`{"stream":"btcusdt@trade","data":{"e":"trade"}}`))
+	assert.Equal(t, `btcusdt@trade {"e":"trade"}`, <-messages)
+
+	require.NoError(t, c.Unsubscribe(ctx, "btcusdt@trade"))
+	assert.Equal(t, []string{"btcusdt@bookTicker"}, c.Streams())
+	assert.False(t, server.subscribed("btcusdt@trade"))
+}
+
+func TestStreamClientSubscribeError(t *testing.T) {
+	_, endpoint := newTestStreamServer(t)
+	c := NewStreamClient(endpoint, nil)
+	require.NoError(t, c.Connect())
+	defer c.Close()
+
+	err := c.Subscribe(context.Background(), func(stream string, data []byte) error { return nil }, "invalid")
+	apiErr, ok := err.(*APIError)
+	require.True(t, ok)
+	assert.Equal(t, int64(2), apiErr.Code)
+	assert.Empty(t, c.Streams())
+}
+
+func TestStreamClientSubscribeErrorKeepHandler(t *testing.T) {
+	server, endpoint := newTestStreamServer(t)
+	c := NewStreamClient(endpoint, nil)
+	require.NoError(t, c.Connect())
+	defer c.Close()
+	conn := <-server.conns
+
+	messages := make(chan string, 10)
+	ctx := context.Background()
+	require.NoError(t, c.Subscribe(ctx, func(stream string, data []byte) error {
+		messages <- "first"
+		return nil
+	}, "btcusdt@trade"))
+	require.Error(t, c.Subscribe(ctx, func(stream string, data []byte) error {
+		messages <- "second"
+		return nil
+	}, "btcusdt@trade", "invalid"))
+
+	require.NoError(t, conn.push(`{"stream":"btcusdt@trade","data":{"e":"trade"}}`))
+	assert.Equal(t, "first", <-messages)
+	assert.Equal(t, []string{"btcusdt@trade"}, c.Streams())
+}
+
+func TestStreamClientTooManyStreams(t *testing.T) {
+	c := NewStreamClient("endpoint", nil)
+	streams := make([]string, MaxStreamsPerConnection+1)
+	for i := range streams {
+		streams[i] = fmt.Sprintf("stream%d", i)
+	}
+	err := c.Subscribe(context.Background(), func(stream string, data []byte) error { return nil }, streams...)
+	assert.Equal(t, ErrorTooManyStreams, err)
+}
+
+func TestStreamClientRateLimit(t *testing.T) {
+	_, endpoint := newTestStreamServer(t)
+	c := NewStreamClient(endpoint, nil)
+	require.NoError(t, c.Connect())
+	defer c.Close()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := c.ListSubscriptions(context.Background())
+		require.NoError(t, err)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 2*time.Second/MaxStreamMessagesPerSecond)
+}
+
+func TestStreamClientResubscribe(t *testing.T) {
+	server, endpoint := newTestStreamServer(t)
+	c := NewStreamClient(endpoint, nil)
+	states := make(chan *WsStateEvent, 100)
+	c.ReconnectPolicy = newTestWsReconnectPolicy(states)
+	c.ErrHandler = func(err error) {}
+	require.NoError(t, c.Connect())
+	defer c.Close()
+	conn := <-server.conns
+	assert.Equal(t, WsStateConnected, (<-states).State)
+
+	require.NoError(t, c.Subscribe(context.Background(), func(stream string, data []byte) error { return nil }, "btcusdt@trade"))
+	conn.Close()
+	<-server.conns
+	assert.Equal(t, WsStateDisconnected, (<-states).State)
+	assert.Equal(t, WsStateReconnecting, (<-states).State)
+	assert.Equal(t, WsStateConnected, (<-states).State)
+	assert.Eventually(t, func() bool {
+		return server.subscribed("btcusdt@trade")
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestStreamClientMaxConnectionAge(t *testing.T) {
+	server, endpoint := newTestStreamServer(t)
+	c := NewStreamClient(endpoint, nil)
+	states := make(chan *WsStateEvent, 100)
+	c.ReconnectPolicy = newTestWsReconnectPolicy(states)
+	c.ReconnectPolicy.MaxConnectionAge = 200 * time.Millisecond
+	c.ErrHandler = func(err error) {}
+	require.NoError(t, c.Connect())
+	defer c.Close()
+	<-server.conns
+	assert.Equal(t, WsStateConnected, (<-states).State)
+
+	messages := make(chan string, 10)
+	require.NoError(t, c.Subscribe(context.Background(), func(stream string, data []byte) error {
+		messages <- stream
+		return nil
+	}, "btcusdt@trade"))
+
+	// the new connection is subscribed to the streams, the old one is closed without disconnection
+	conn := <-server.conns
+	require.Eventually(t, func() bool {
+		return server.subscribed("btcusdt@trade")
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, conn.push(`{"stream":"btcusdt@trade","data":{"e":"trade"}}`))
+	assert.Equal(t, "btcusdt@trade", <-messages)
+	assert.Empty(t, states)
+}
+
+func TestStreamClientClose(t *testing.T) {
+	_, endpoint := newTestStreamServer(t)
+	c := NewStreamClient(endpoint, nil)
+	require.NoError(t, c.Connect())
+	require.NoError(t, c.Close())
+	<-c.Done()
+	_, err := c.ListSubscriptions(context.Background())
+	assert.Equal(t, ErrorStreamClientClosed, err)
+}
+
+func TestStreamClientConnectTwice(t *testing.T) {
+	_, endpoint := newTestStreamServer(t)
+	c := NewStreamClient(endpoint, nil)
+	require.NoError(t, c.Connect())
+	assert.Equal(t, ErrorStreamClientConnected, c.Connect())
+	require.NoError(t, c.Close())
+	<-c.Done()
+	assert.Equal(t, ErrorStreamClientClosed, c.Connect())
+
+	// a client closed before connecting can not be connected
+	c = NewStreamClient(endpoint, nil)
+	require.NoError(t, c.Close())
+	<-c.Done()
+	assert.Equal(t, ErrorStreamClientClosed, c.Connect())
+
+	// a failed Connect can be retried
+	c = NewStreamClient("ws://127.0.0.1:1/stream", nil)
+	assert.Error(t, c.Connect())
+	err := c.Connect()
+	assert.Error(t, err)
+	assert.NotEqual(t, ErrorStreamClientConnected, err)
+}
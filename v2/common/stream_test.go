@@ -0,0 +1,134 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testStream is a connection whose events are pushed by the test,
+// like a websocket connection its handler is not called once doneC is closed
+type testStream struct {
+	mu         sync.Mutex
+	handler    func(event int)
+	errHandler func(err error)
+	doneC      chan struct{}
+	stopC      chan struct{}
+}
+
+func (s *testStream) serve(handler func(event int), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	s.handler = handler
+	s.errHandler = errHandler
+	s.doneC = make(chan struct{})
+	s.stopC = make(chan struct{})
+	go func() {
+		<-s.stopC
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		close(s.doneC)
+	}()
+	return s.doneC, s.stopC, nil
+}
+
+func (s *testStream) push(event int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handler(event)
+}
+
+func drain(eventC <-chan int) []int {
+	var events []int
+	for event := range eventC {
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestStreamCancel(t *testing.T) {
+	s := new(testStream)
+	ctx, cancel := context.WithCancel(context.Background())
+	eventC, errC, err := Stream(ctx, s.serve, nil)
+	require.NoError(t, err)
+
+	s.push(1)
+	s.errHandler(errors.New("error"))
+	assert.Equal(t, 1, <-eventC)
+	assert.EqualError(t, <-errC, "error")
+
+	cancel()
+	<-s.doneC
+	assert.Empty(t, drain(eventC))
+	_, ok := <-errC
+	assert.False(t, ok)
+}
+
+func TestStreamConnectionLost(t *testing.T) {
+	s := new(testStream)
+	eventC, errC, err := Stream(context.Background(), s.serve, nil)
+	require.NoError(t, err)
+
+	s.push(1)
+	s.errHandler(errors.New("read error"))
+	close(s.stopC)
+	assert.Equal(t, []int{1}, drain(eventC))
+	assert.EqualError(t, <-errC, "read error")
+}
+
+func TestStreamDropOldest(t *testing.T) {
+	s := new(testStream)
+	ctx, cancel := context.WithCancel(context.Background())
+	eventC, _, err := Stream(ctx, s.serve, &StreamOptions{BufferSize: 2, OverflowPolicy: OverflowPolicyDropOldest})
+	require.NoError(t, err)
+
+	for i := 1; i <= 4; i++ {
+		s.push(i)
+	}
+	cancel()
+	assert.Equal(t, []int{3, 4}, drain(eventC))
+}
+
+func TestStreamOverflowError(t *testing.T) {
+	s := new(testStream)
+	eventC, errC, err := Stream(context.Background(), s.serve, &StreamOptions{BufferSize: 1, OverflowPolicy: OverflowPolicyError})
+	require.NoError(t, err)
+
+	s.push(1)
+	s.push(2)
+	<-s.doneC
+	assert.Equal(t, []int{1}, drain(eventC))
+	assert.Equal(t, ErrorStreamOverflow, <-errC)
+}
+
+func TestStreamBlock(t *testing.T) {
+	s := new(testStream)
+	ctx, cancel := context.WithCancel(context.Background())
+	eventC, _, err := Stream(ctx, s.serve, &StreamOptions{BufferSize: 1, OverflowPolicy: OverflowPolicyBlock})
+	require.NoError(t, err)
+
+	s.push(1)
+	blockedC := make(chan struct{})
+	go func() {
+		defer close(blockedC)
+		s.push(2)
+	}()
+	assert.Equal(t, 1, <-eventC)
+	<-blockedC
+	assert.Equal(t, 2, <-eventC)
+
+	// a blocked handler returns when the stream is cancelled
+	s.push(3)
+	go cancel()
+	s.push(4)
+	assert.Equal(t, []int{3}, drain(eventC))
+}
+
+func TestStreamServeError(t *testing.T) {
+	_, _, err := Stream(context.Background(), func(handler func(event int), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return nil, nil, errors.New("dial error")
+	}, nil)
+	assert.EqualError(t, err, "dial error")
+}
@@ -0,0 +1,108 @@
+package common
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// UsedRateLimit define the usage of a rate limit as returned in the rateLimits field of the websocket API responses
+type UsedRateLimit struct {
+	RateLimit
+	Count int64 `json:"count"`
+}
+
+// UsedLimit define the usage of a rate limit interval as last reported by the server
+type UsedLimit struct {
+	Used       int64
+	UpdateTime time.Time
+}
+
+// UsedLimitsSnapshot define the usage of the rate limits by interval key, e.g. 1M, 10S, 1D.
+// An interval is kept until it is reported again, UpdateTime tells how fresh it is.
+type UsedLimitsSnapshot struct {
+	UsedWeight map[string]UsedLimit
+	OrderCount map[string]UsedLimit
+}
+
+// UsedLimits keeps the usage of the REQUEST_WEIGHT and ORDERS limits reported by the X-MBX-USED-WEIGHT-* and
+// X-MBX-ORDER-COUNT-* response headers and by the rateLimits of the websocket API responses.
+// It is safe for concurrent use and can be shared by several clients, a nil UsedLimits ignores the updates.
+type UsedLimits struct {
+	mu         sync.RWMutex
+	usedWeight map[string]UsedLimit
+	orderCount map[string]UsedLimit
+	now        func() time.Time
+}
+
+// NewUsedLimits init an empty UsedLimits
+func NewUsedLimits() *UsedLimits {
+	return &UsedLimits{
+		usedWeight: make(map[string]UsedLimit),
+		orderCount: make(map[string]UsedLimit),
+		now:        time.Now,
+	}
+}
+
+// Update sets the usage from the headers of a response
+func (l *UsedLimits) Update(header http.Header) {
+	if l == nil || header == nil {
+		return
+	}
+	usedWeight, orderCount := ParseUsedLimits(header)
+	if len(usedWeight) == 0 && len(orderCount) == 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	for k, used := range usedWeight {
+		l.usedWeight[k] = UsedLimit{Used: used, UpdateTime: now}
+	}
+	for k, count := range orderCount {
+		l.orderCount[k] = UsedLimit{Used: count, UpdateTime: now}
+	}
+}
+
+// UpdateRateLimits sets the usage from the rateLimits of a websocket API response
+func (l *UsedLimits) UpdateRateLimits(rateLimits []UsedRateLimit) {
+	if l == nil || len(rateLimits) == 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	for _, rl := range rateLimits {
+		if rl.Interval == "" {
+			continue
+		}
+		used := UsedLimit{Used: rl.Count, UpdateTime: now}
+		key := rateLimitIntervalKey(rl.Interval, rl.IntervalNum)
+		switch rl.RateLimitType {
+		case RateLimitTypeRequestWeight:
+			l.usedWeight[key] = used
+		case RateLimitTypeOrders:
+			l.orderCount[key] = used
+		}
+	}
+}
+
+// Snapshot return a copy of the current usage
+func (l *UsedLimits) Snapshot() UsedLimitsSnapshot {
+	snapshot := UsedLimitsSnapshot{
+		UsedWeight: make(map[string]UsedLimit),
+		OrderCount: make(map[string]UsedLimit),
+	}
+	if l == nil {
+		return snapshot
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for k, v := range l.usedWeight {
+		snapshot.UsedWeight[k] = v
+	}
+	for k, v := range l.orderCount {
+		snapshot.OrderCount[k] = v
+	}
+	return snapshot
+}
@@ -0,0 +1,76 @@
+package common
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsedLimits(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := NewUsedLimits()
+	l.now = func() time.Time { return now }
+
+	header := http.Header{}
+	header.Set("X-Mbx-Used-Weight-1m", "20")
+	header.Set("X-Mbx-Order-Count-10s", "1")
+	header.Set("X-Mbx-Order-Count-1d", "5")
+	l.Update(header)
+
+	now = now.Add(time.Second)
+	l.UpdateRateLimits([]UsedRateLimit{
+		{RateLimit: RateLimit{RateLimitType: RateLimitTypeRequestWeight, Interval: "MINUTE", IntervalNum: 1, Limit: 2400}, Count: 21},
+		{RateLimit: RateLimit{RateLimitType: RateLimitTypeOrders, Interval: "SECOND", IntervalNum: 10, Limit: 300}, Count: 2},
+	})
+
+	assert.Equal(t, UsedLimitsSnapshot{
+		UsedWeight: map[string]UsedLimit{
+			"1M": {Used: 21, UpdateTime: now},
+		},
+		OrderCount: map[string]UsedLimit{
+			"10S": {Used: 2, UpdateTime: now},
+			"1D":  {Used: 5, UpdateTime: now.Add(-time.Second)},
+		},
+	}, l.Snapshot())
+}
+
+func TestUsedLimitsSnapshotIsCopy(t *testing.T) {
+	l := NewUsedLimits()
+	header := http.Header{}
+	header.Set("X-Mbx-Used-Weight-1m", "20")
+	l.Update(header)
+
+	snapshot := l.Snapshot()
+	snapshot.UsedWeight["1M"] = UsedLimit{Used: 0}
+	assert.Equal(t, int64(20), l.Snapshot().UsedWeight["1M"].Used)
+}
+
+func TestUsedLimitsConcurrent(t *testing.T) {
+	l := NewUsedLimits()
+	header := http.Header{}
+	header.Set("X-Mbx-Used-Weight-1m", "20")
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			l.Update(header)
+		}()
+		go func() {
+			defer wg.Done()
+			l.Snapshot()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int64(20), l.Snapshot().UsedWeight["1M"].Used)
+}
+
+func TestUsedLimitsNil(t *testing.T) {
+	var l *UsedLimits
+	l.Update(http.Header{"X-Mbx-Used-Weight-1m": {"20"}})
+	l.UpdateRateLimits([]UsedRateLimit{{RateLimit: RateLimit{RateLimitType: RateLimitTypeOrders, Interval: "SECOND", IntervalNum: 10}, Count: 1}})
+	assert.Empty(t, l.Snapshot().UsedWeight)
+}
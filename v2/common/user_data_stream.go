@@ -0,0 +1,228 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	defaultUserDataStreamKeepaliveInterval = 30 * time.Minute
+	defaultUserDataStreamReconnectDelay    = time.Second
+)
+
+var (
+	// ErrorListenKeyExpired is the reason of a gap caused by a listenKeyExpired event
+	ErrorListenKeyExpired = errors.New("user data stream: listen key expired")
+	// ErrorUserDataStreamDisconnected is the reason of a gap caused by a lost connection
+	ErrorUserDataStreamDisconnected = errors.New("user data stream: disconnected")
+)
+
+// StartListenKeyFunc create a listen key, or return the active one
+type StartListenKeyFunc func(ctx context.Context) (listenKey string, err error)
+
+// ListenKeyFunc keepalive or close a listen key
+type ListenKeyFunc func(ctx context.Context, listenKey string) error
+
+// UserDataServeFunc connect to the user data stream of the listen key without reconnecting,
+// expire must be called on a listenKeyExpired event
+type UserDataServeFunc func(listenKey string, expire func(), errHandler func(err error)) (doneC, stopC chan struct{}, err error)
+
+// UserDataStreamGap define a period during which user data events may have been missed,
+// the state should be reconciled using the REST API
+type UserDataStreamGap struct {
+	// Start is the time the previous connection was lost
+	Start time.Time
+	// End is the time the stream was connected again
+	End time.Time
+	// Err is the reason of the reconnection
+	Err error
+}
+
+// UserDataStream keeps a user data stream connected: the listen key is kept alive,
+// renewed when it expires, and the stream is reconnected when the connection is lost.
+type UserDataStream struct {
+	// GapHandler is called once the stream has been reconnected
+	GapHandler func(gap *UserDataStreamGap)
+	// ErrHandler is called for stream and listen key errors
+	ErrHandler func(err error)
+	// KeepaliveInterval between two listen key keepalives, 30 minutes by default or when not positive
+	KeepaliveInterval time.Duration
+	// ReconnectDelay between two failed reconnections
+	ReconnectDelay time.Duration
+
+	start     StartListenKeyFunc
+	keepalive ListenKeyFunc
+	close     ListenKeyFunc
+	serve     UserDataServeFunc
+
+	mu        sync.RWMutex
+	listenKey string
+	expired   chan struct{}
+}
+
+// NewUserDataStream init a UserDataStream using the listen key functions and serve to connect
+func NewUserDataStream(start StartListenKeyFunc, keepalive, close ListenKeyFunc, serve UserDataServeFunc) *UserDataStream {
+	return &UserDataStream{
+		KeepaliveInterval: defaultUserDataStreamKeepaliveInterval,
+		ReconnectDelay:    defaultUserDataStreamReconnectDelay,
+		start:             start,
+		keepalive:         keepalive,
+		close:             close,
+		serve:             serve,
+		expired:           make(chan struct{}, 1),
+	}
+}
+
+// OnGap set the handler called once the stream has been reconnected,
+// the events received during the gap are lost and the state should be fetched again from the REST API
+func (s *UserDataStream) OnGap(handler func(gap *UserDataStreamGap)) *UserDataStream {
+	s.GapHandler = handler
+	return s
+}
+
+// OnError set the handler called for stream and listen key errors
+func (s *UserDataStream) OnError(errHandler func(err error)) *UserDataStream {
+	s.ErrHandler = errHandler
+	return s
+}
+
+// Keepalive set the interval between two listen key keepalives, 30 minutes by default
+func (s *UserDataStream) Keepalive(interval time.Duration) *UserDataStream {
+	s.KeepaliveInterval = interval
+	return s
+}
+
+// ListenKey returns the listen key of the current connection, or of the last connection attempt
+func (s *UserDataStream) ListenKey() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.listenKey
+}
+
+// Expire renews the listen key and reconnects the stream, it is called on a listenKeyExpired event
+func (s *UserDataStream) Expire() {
+	select {
+	case s.expired <- struct{}{}:
+	default:
+	}
+}
+
+// Serve connects to the user data stream and keeps it connected until stopC is closed,
+// the listen key is closed once the stream is done
+func (s *UserDataStream) Serve() (doneC, stopC chan struct{}, err error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	wsDoneC, wsStopC, err := s.connect(ctx)
+	if err != nil {
+		cancel()
+		return nil, nil, err
+	}
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	go func() {
+		defer close(doneC)
+		defer cancel()
+		s.run(ctx, wsDoneC, wsStopC, stopC)
+	}()
+	return doneC, stopC, nil
+}
+
+func (s *UserDataStream) connect(ctx context.Context) (doneC, stopC chan struct{}, err error) {
+	listenKey, err := s.start(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the listen key is set before serving so that it is closed once the stream is done, even if serve fails
+	s.mu.Lock()
+	s.listenKey = listenKey
+	s.mu.Unlock()
+	return s.serve(listenKey, s.Expire, s.handleErr)
+}
+
+func (s *UserDataStream) run(ctx context.Context, wsDoneC, wsStopC, stopC chan struct{}) {
+	ticker := time.NewTicker(s.keepaliveInterval())
+	defer ticker.Stop()
+	for {
+		var reason error
+		select {
+		case <-stopC:
+			close(wsStopC)
+			<-wsDoneC
+			s.closeListenKey(ctx)
+			return
+		case <-ticker.C:
+			err := s.keepalive(ctx, s.ListenKey())
+			if err == nil {
+				continue
+			}
+			s.handleErr(err)
+			if !errors.Is(err, ErrorCodeInvalidListenKey) {
+				// e.g. a timeout or a server error, the keepalive is retried on the next tick
+				continue
+			}
+			// the listen key does not exist anymore, a new one is created
+			reason = err
+		case <-s.expired:
+			reason = ErrorListenKeyExpired
+		case <-wsDoneC:
+			reason = ErrorUserDataStreamDisconnected
+		}
+
+		gap := &UserDataStreamGap{Start: time.Now(), Err: reason}
+		close(wsStopC)
+		<-wsDoneC
+		// drop the expiration reported by the previous connection
+		select {
+		case <-s.expired:
+		default:
+		}
+		var ok bool
+		wsDoneC, wsStopC, ok = s.reconnect(ctx, stopC)
+		if !ok {
+			s.closeListenKey(ctx)
+			return
+		}
+		ticker.Reset(s.keepaliveInterval())
+		gap.End = time.Now()
+		if s.GapHandler != nil {
+			s.GapHandler(gap)
+		}
+	}
+}
+
+// reconnect retries to connect until it succeeds, ok is false when stopC is closed before
+func (s *UserDataStream) reconnect(ctx context.Context, stopC chan struct{}) (doneC, wsStopC chan struct{}, ok bool) {
+	for {
+		doneC, wsStopC, err := s.connect(ctx)
+		if err == nil {
+			return doneC, wsStopC, true
+		}
+		s.handleErr(err)
+		select {
+		case <-stopC:
+			return nil, nil, false
+		case <-time.After(s.ReconnectDelay):
+		}
+	}
+}
+
+// closeListenKey closes the listen key of the last connection
+func (s *UserDataStream) closeListenKey(ctx context.Context) {
+	if err := s.close(ctx, s.ListenKey()); err != nil {
+		s.handleErr(err)
+	}
+}
+
+func (s *UserDataStream) keepaliveInterval() time.Duration {
+	if s.KeepaliveInterval <= 0 {
+		return defaultUserDataStreamKeepaliveInterval
+	}
+	return s.KeepaliveInterval
+}
+
+func (s *UserDataStream) handleErr(err error) {
+	if s.ErrHandler != nil {
+		s.ErrHandler(err)
+	}
+}
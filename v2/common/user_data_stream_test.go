@@ -0,0 +1,245 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testUserDataStream records the listen key calls and the connections of a UserDataStream
+type testUserDataStream struct {
+	mu         sync.Mutex
+	keys       int
+	startErr   error
+	keepalives []string
+	keepErr    error
+	serveErr   error
+	closed     []string
+	connC      chan *testUserDataConn
+}
+
+type testUserDataConn struct {
+	listenKey string
+	doneC     chan struct{}
+	stopC     chan struct{}
+	// dropC is closed to simulate a lost connection
+	dropC chan struct{}
+}
+
+func newTestUserDataStream() *testUserDataStream {
+	return &testUserDataStream{connC: make(chan *testUserDataConn, 10)}
+}
+
+func (t *testUserDataStream) start(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.startErr != nil {
+		err := t.startErr
+		t.startErr = nil
+		return "", err
+	}
+	t.keys++
+	return fmt.Sprintf("key%d", t.keys), nil
+}
+
+func (t *testUserDataStream) keepalive(ctx context.Context, listenKey string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.keepalives = append(t.keepalives, listenKey)
+	err := t.keepErr
+	t.keepErr = nil
+	return err
+}
+
+func (t *testUserDataStream) close(ctx context.Context, listenKey string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.closed = append(t.closed, listenKey)
+	return nil
+}
+
+func (t *testUserDataStream) serve(listenKey string, expire func(), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	t.mu.Lock()
+	err = t.serveErr
+	t.mu.Unlock()
+	if err != nil {
+		return nil, nil, err
+	}
+	conn := &testUserDataConn{
+		listenKey: listenKey,
+		doneC:     make(chan struct{}),
+		stopC:     make(chan struct{}),
+		dropC:     make(chan struct{}),
+	}
+	go func() {
+		select {
+		case <-conn.stopC:
+		case <-conn.dropC:
+		}
+		close(conn.doneC)
+	}()
+	t.connC <- conn
+	return conn.doneC, conn.stopC, nil
+}
+
+func (t *testUserDataStream) newStream() *UserDataStream {
+	return NewUserDataStream(t.start, t.keepalive, t.close, t.serve)
+}
+
+func TestUserDataStreamReconnect(t *testing.T) {
+	ts := newTestUserDataStream()
+	s := ts.newStream()
+	s.ReconnectDelay = time.Millisecond
+	gapC := make(chan *UserDataStreamGap, 10)
+	s.GapHandler = func(gap *UserDataStreamGap) {
+		gapC <- gap
+	}
+	errC := make(chan error, 10)
+	s.ErrHandler = func(err error) {
+		errC <- err
+	}
+
+	doneC, stopC, err := s.Serve()
+	require.NoError(t, err)
+	conn := <-ts.connC
+	assert.Equal(t, "key1", conn.listenKey)
+	assert.Equal(t, "key1", s.ListenKey())
+
+	// the connection is lost, the first reconnection fails
+	ts.mu.Lock()
+	ts.startErr = errors.New("start error")
+	ts.mu.Unlock()
+	close(conn.dropC)
+	assert.EqualError(t, <-errC, "start error")
+	conn = <-ts.connC
+	assert.Equal(t, "key2", conn.listenKey)
+	gap := <-gapC
+	assert.ErrorIs(t, gap.Err, ErrorUserDataStreamDisconnected)
+	assert.False(t, gap.End.Before(gap.Start))
+
+	// a listenKeyExpired event renews the listen key
+	s.Expire()
+	next := <-ts.connC
+	assert.Equal(t, "key3", next.listenKey)
+	assert.ErrorIs(t, (<-gapC).Err, ErrorListenKeyExpired)
+	_, ok := <-conn.doneC
+	assert.False(t, ok)
+	assert.Equal(t, "key3", s.ListenKey())
+
+	close(stopC)
+	<-doneC
+	_, ok = <-next.doneC
+	assert.False(t, ok)
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	assert.Equal(t, []string{"key3"}, ts.closed)
+}
+
+func TestUserDataStreamKeepalive(t *testing.T) {
+	ts := newTestUserDataStream()
+	s := ts.newStream()
+	s.KeepaliveInterval = 5 * time.Millisecond
+	gapC := make(chan *UserDataStreamGap, 10)
+	s.GapHandler = func(gap *UserDataStreamGap) {
+		gapC <- gap
+	}
+
+	doneC, stopC, err := s.Serve()
+	require.NoError(t, err)
+	<-ts.connC
+	require.Eventually(t, func() bool {
+		ts.mu.Lock()
+		defer ts.mu.Unlock()
+		return len(ts.keepalives) >= 2
+	}, time.Second, time.Millisecond)
+
+	// a keepalive failing with another error is retried on the next tick
+	ts.mu.Lock()
+	ts.keepErr = errors.New("timeout")
+	ts.mu.Unlock()
+	require.Eventually(t, func() bool {
+		ts.mu.Lock()
+		defer ts.mu.Unlock()
+		return ts.keepErr == nil && len(ts.keepalives) >= 4
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, "key1", s.ListenKey())
+	assert.Empty(t, gapC)
+
+	// the listen key does not exist anymore, a new one is created
+	keepErr := &APIError{Code: -1125, Message: "This listenKey does not exist."}
+	ts.mu.Lock()
+	ts.keepErr = keepErr
+	ts.mu.Unlock()
+	conn := <-ts.connC
+	assert.Equal(t, "key2", conn.listenKey)
+	assert.ErrorIs(t, (<-gapC).Err, ErrorCodeInvalidListenKey)
+
+	close(stopC)
+	<-doneC
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	assert.Equal(t, "key1", ts.keepalives[0])
+	assert.Equal(t, []string{"key2"}, ts.closed)
+}
+
+func TestUserDataStreamKeepaliveIntervalNotPositive(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		ts := newTestUserDataStream()
+		s := ts.newStream().Keepalive(interval)
+		assert.Equal(t, defaultUserDataStreamKeepaliveInterval, s.keepaliveInterval())
+
+		doneC, stopC, err := s.Serve()
+		require.NoError(t, err)
+		conn := <-ts.connC
+		// the ticker is reset after the reconnection
+		close(conn.dropC)
+		<-ts.connC
+		close(stopC)
+		<-doneC
+	}
+}
+
+func TestUserDataStreamStopWhileReconnecting(t *testing.T) {
+	ts := newTestUserDataStream()
+	s := ts.newStream()
+	s.ReconnectDelay = time.Millisecond
+	errC := make(chan error, 10)
+	s.ErrHandler = func(err error) {
+		select {
+		case errC <- err:
+		default:
+		}
+	}
+
+	doneC, stopC, err := s.Serve()
+	require.NoError(t, err)
+	conn := <-ts.connC
+
+	// the new listen key is created but the stream can not be served
+	ts.mu.Lock()
+	ts.serveErr = errors.New("serve error")
+	ts.mu.Unlock()
+	close(conn.dropC)
+	assert.EqualError(t, <-errC, "serve error")
+	close(stopC)
+	<-doneC
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	require.NotEmpty(t, ts.closed)
+	assert.Equal(t, fmt.Sprintf("key%d", ts.keys), ts.closed[len(ts.closed)-1])
+	assert.NotEqual(t, "key1", ts.closed[len(ts.closed)-1])
+}
+
+func TestUserDataStreamServeError(t *testing.T) {
+	ts := newTestUserDataStream()
+	ts.startErr = errors.New("start error")
+	_, _, err := ts.newStream().Serve()
+	assert.EqualError(t, err, "start error")
+}
@@ -5,14 +5,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
-	"os"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/jpillora/backoff"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 //go:generate mockgen -source client.go -destination mock/client.go -package mock
@@ -32,6 +32,9 @@ var (
 	// ErrorWsIdAlreadySent defines that request with the same id was already sent
 	ErrorWsIdAlreadySent = errors.New("ws error: request with same id already sent")
 
+	// ErrorWsConnectionLost defines that the connection has been lost before the response was received
+	ErrorWsConnectionLost = errors.New("ws error: connection lost")
+
 	// KeepAlivePingDeadline defines deadline to send ping frame
 	KeepAlivePingDeadline = 10 * time.Second
 
@@ -39,15 +42,45 @@ var (
 	WaitCheckInternal = 300 * time.Millisecond
 )
 
-// messageId define id field of request/response
+// messageId define id field of request/response, and the usage of the rate limits returned in the responses
 type messageId struct {
-	Id string `json:"id"`
+	Id         string                 `json:"id"`
+	RateLimits []common.UsedRateLimit `json:"rateLimits"`
+}
+
+// ConnectionLostError is returned to the synchronous calls in flight when the connection is lost,
+// the request may or may not have been processed by the server
+type ConnectionLostError struct {
+	// Err is the error which caused the connection loss
+	Err error
+}
+
+// Error return the cause of the connection loss
+func (e *ConnectionLostError) Error() string {
+	return fmt.Sprintf("%s: %v", ErrorWsConnectionLost, e.Err)
+}
+
+// Unwrap return the cause of the connection loss
+func (e *ConnectionLostError) Unwrap() error {
+	return e.Err
+}
+
+// Is makes errors.Is(err, ErrorWsConnectionLost) match any ConnectionLostError
+func (e *ConnectionLostError) Is(target error) bool {
+	return target == ErrorWsConnectionLost
+}
+
+// callResult define the response of a synchronous call
+type callResult struct {
+	data []byte
+	err  error
 }
 
 // client define API websocket client
 type client struct {
-	Debug                       bool
-	logger                      *log.Logger
+	logger                      common.Logger
+	usedLimits                  *common.UsedLimits
+	optionsMu                   sync.RWMutex
 	conn                        Connection
 	connMu                      sync.Mutex
 	reconnectSignal             chan struct{}
@@ -56,18 +89,45 @@ type client struct {
 	readC                       chan []byte
 	readErrChan                 chan error
 	reconnectCount              int64
+	reconnectHandlers           []func()
+	reconnectHandlersMu         sync.Mutex
 }
 
-func (c *client) debug(format string, v ...interface{}) {
-	if c.Debug {
-		c.logger.Println(fmt.Sprintf(format, v...))
+// log return the logger of the client
+func (c *client) log() common.Logger {
+	c.optionsMu.RLock()
+	defer c.optionsMu.RUnlock()
+	return c.logger
+}
+
+// SetLogger sets the logger of the client, the api keys and signatures of the requests are redacted
+func (c *client) SetLogger(logger common.Logger) {
+	if logger == nil {
+		logger = common.NopLogger
 	}
+	c.optionsMu.Lock()
+	defer c.optionsMu.Unlock()
+	c.logger = logger
+}
+
+// SetUsedLimits sets the UsedLimits updated from the rateLimits of the responses, e.g. the one of a REST client
+func (c *client) SetUsedLimits(usedLimits *common.UsedLimits) {
+	c.optionsMu.Lock()
+	defer c.optionsMu.Unlock()
+	c.usedLimits = usedLimits
+}
+
+// getUsedLimits return the UsedLimits of the client, nil if not set
+func (c *client) getUsedLimits() *common.UsedLimits {
+	c.optionsMu.RLock()
+	defer c.optionsMu.RUnlock()
+	return c.usedLimits
 }
 
 // NewClient init client
 func NewClient(conn Connection) (Client, error) {
 	client := &client{
-		logger:                      log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		logger:                      common.NopLogger,
 		conn:                        conn,
 		connMu:                      sync.Mutex{},
 		reconnectSignal:             make(chan struct{}, 1),
@@ -92,64 +152,107 @@ type Client interface {
 	Wait(timeout time.Duration)
 }
 
-// Write sends data into websocket connection
+// ContextClient is implemented by the clients created by NewClient.
+// It is optional for the other implementations of Client, see WriteSyncContext.
+type ContextClient interface {
+	Client
+	WriteSyncContext(ctx context.Context, id string, data []byte) ([]byte, error)
+}
+
+// ConfigurableClient is implemented by the clients created by NewClient.
+// It is optional for the other implementations of Client, the callers type assert for it.
+type ConfigurableClient interface {
+	Client
+	OnReconnect(handler func())
+	SetLogger(logger common.Logger)
+	SetUsedLimits(usedLimits *common.UsedLimits)
+}
+
+// WriteSyncContext sends data with c and waits for the response until ctx is done. When c does not implement
+// ContextClient, c.WriteSync is used with the time left until the deadline of ctx, WriteSyncWsTimeout without deadline.
+func WriteSyncContext(ctx context.Context, c Client, id string, data []byte) ([]byte, error) {
+	if cc, ok := c.(ContextClient); ok {
+		return cc.WriteSyncContext(ctx, id, data)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	timeout := WriteSyncWsTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+	response, err := c.WriteSync(id, data, timeout)
+	if err != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return response, err
+}
+
+// Write sends data into websocket connection, the response is delivered into the read channel
 func (c *client) Write(id string, data []byte) error {
 	c.connMu.Lock()
 	defer c.connMu.Unlock()
 
-	if c.requestsList.IsAlreadyInList(id) {
+	// register the request before writing, the response may be read before WriteMessage returns
+	if !c.requestsList.add(id, nil) {
 		return ErrorWsIdAlreadySent
 	}
+	c.logWrite(id, data)
 
 	if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
-		c.debug("write: unable to write message into websocket conn '%v'", err)
+		c.log().Warn("write: unable to write message into websocket conn", "id", id, "error", err)
+		c.requestsList.Remove(id)
 		return err
 	}
 
-	c.requestsList.Add(id)
-
 	return nil
 }
 
-// WriteSync sends data to the websocket connection and waits for a response synchronously
-// Should be used separately from the asynchronous Write method (do not send anything in parallel)
+// WriteSync sends data to the websocket connection and waits for the response with the same id until timeout expired
 func (c *client) WriteSync(id string, data []byte, timeout time.Duration) ([]byte, error) {
-	c.connMu.Lock()
-	defer c.connMu.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
 
-	if err := c.conn.WriteMessage(websocket.TextMessage, data); err != nil {
-		c.debug("write sync: unable to write message into websocket conn '%v'", err)
-		return nil, err
+	response, err := c.WriteSyncContext(ctx, id, data)
+	if errors.Is(err, context.DeadlineExceeded) {
+		c.log().Warn("write sync: timeout expired", "id", id)
+		return nil, ErrorWsReadConnectionTimeout
 	}
+	return response, err
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
+// WriteSyncContext sends data to the websocket connection and waits for the response with the same id until ctx is done.
+// It is safe to call concurrently and together with Write, responses are matched to calls by id.
+// If the connection is lost before the response is received, a *ConnectionLostError is returned.
+func (c *client) WriteSyncContext(ctx context.Context, id string, data []byte) ([]byte, error) {
+	responseC := make(chan callResult, 1)
+	if err := c.requestsList.addCall(id, responseC); err != nil {
+		return nil, err
+	}
+	defer c.requestsList.removeCall(id, responseC)
 
-	for {
-		select {
-		case <-ctx.Done():
-			c.debug("write sync: timeout expired")
-			return nil, ErrorWsReadConnectionTimeout
-		case rawData := <-c.readC:
-			// check that the correct response from websocket has been read
-			msg := messageId{}
-			err := json.Unmarshal(rawData, &msg)
-			if err != nil {
-				return nil, err
-			}
-			if msg.Id != id {
-				c.debug("write sync: wrong response with id '%v' has been read", msg.Id)
-				continue
-			}
+	c.logWrite(id, data)
+	c.connMu.Lock()
+	err := c.conn.WriteMessage(websocket.TextMessage, data)
+	c.connMu.Unlock()
+	if err != nil {
+		c.log().Warn("write sync: unable to write message into websocket conn", "id", id, "error", err)
+		return nil, err
+	}
 
-			return rawData, nil
-		case err := <-c.readErrChan:
-			c.debug("write sync: error read '%v'", err)
-			return nil, err
-		}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-responseC:
+		return res.data, res.err
 	}
 }
 
+// logWrite logs a request, its api key and signature are redacted
+func (c *client) logWrite(id string, data []byte) {
+	c.log().Debug("write: sending message", "id", id, "request", common.RedactedJSON(data))
+}
+
 func (c *client) GetReadChannel() <-chan []byte {
 	return c.readC
 }
@@ -162,6 +265,13 @@ func (c *client) Wait(timeout time.Duration) {
 	c.wait(timeout)
 }
 
+// OnReconnect adds a handler called each time the connection has been restored, e.g. to log on the session again
+func (c *client) OnReconnect(handler func()) {
+	c.reconnectHandlersMu.Lock()
+	defer c.reconnectHandlersMu.Unlock()
+	c.reconnectHandlers = append(c.reconnectHandlers, handler)
+}
+
 // read data from connection
 func (c *client) read() {
 	defer func() {
@@ -172,40 +282,62 @@ func (c *client) read() {
 	}()
 
 	for {
-		c.debug("read: waiting for message")
+		c.log().Debug("read: waiting for message")
 		_, message, err := c.conn.ReadMessage()
 		if err != nil {
-			c.debug("read: error reading message '%v'", err)
+			c.log().Warn("read: error reading message", "error", err)
+			c.requestsList.failCalls(&ConnectionLostError{Err: err})
 			c.reconnectSignal <- struct{}{}
-			c.readErrChan <- err
+			c.sendReadError(err)
 
-			c.debug("read: wait to get connected")
+			c.log().Debug("read: wait to get connected")
 			<-c.connectionEstablishedSignal
 
 			// refresh map after reconnect to avoid useless waiting after stop application
 			c.requestsList.RecreateList()
 
-			c.debug("read: connection established")
+			c.log().Info("read: connection established")
 			continue
 		}
-		c.debug("read: got new message")
+		c.log().Debug("read: got new message")
 
 		msg := messageId{}
 		err = json.Unmarshal(message, &msg)
 		if err != nil {
-			c.debug("read: error unmarshalling message '%v'", err)
-			c.readErrChan <- err
+			c.log().Warn("read: error unmarshalling message", "error", err)
+			c.sendReadError(err)
 			continue
 		}
+		c.getUsedLimits().UpdateRateLimits(msg.RateLimits)
 
-		c.debug("read: sending message into read channel '%v'", msg)
+		if c.requestsList.resolveCall(msg.Id, message) {
+			c.log().Debug("read: response delivered to pending call", "id", msg.Id)
+			continue
+		}
+
+		// responses of calls which are not pending anymore, e.g. after a timeout, are dropped
+		if msg.Id != "" && !c.requestsList.IsAlreadyInList(msg.Id) {
+			c.log().Debug("read: drop response of unknown request", "id", msg.Id)
+			continue
+		}
+
+		c.log().Debug("read: sending message into read channel", "id", msg.Id)
 		c.readC <- message
 
-		c.debug("read: remove message from request list '%v'", msg)
+		c.log().Debug("read: remove message from request list", "id", msg.Id)
 		c.requestsList.Remove(msg.Id)
 	}
 }
 
+// sendReadError sends err into the read error channel, the error is dropped if the channel is full
+func (c *client) sendReadError(err error) {
+	select {
+	case c.readErrChan <- err:
+	default:
+		c.log().Warn("read: error channel is full, drop error", "error", err)
+	}
+}
+
 // wait until all responses received
 // make sure that you are not sending requests
 func (c *client) wait(timeout time.Duration) {
@@ -230,7 +362,7 @@ func (c *client) wait(timeout time.Duration) {
 // handleReconnect waits for reconnect signal and starts reconnect
 func (c *client) handleReconnect() {
 	for _ = range c.reconnectSignal {
-		c.debug("reconnect: received signal")
+		c.log().Info("reconnect: received signal")
 
 		b := &backoff.Backoff{
 			Min:    reconnectMinInterval,
@@ -247,8 +379,15 @@ func (c *client) handleReconnect() {
 		c.conn = conn
 		c.connMu.Unlock()
 
-		c.debug("reconnect: connected")
+		c.log().Info("reconnect: connected")
 		c.connectionEstablishedSignal <- struct{}{}
+
+		c.reconnectHandlersMu.Lock()
+		handlers := append([]func(){}, c.reconnectHandlers...)
+		c.reconnectHandlersMu.Unlock()
+		for _, handler := range handlers {
+			handler()
+		}
 	}
 }
 
@@ -259,7 +398,7 @@ func (c *client) startReconnect(b *backoff.Backoff) Connection {
 		conn, err := c.conn.RestoreConnection()
 		if err != nil {
 			delay := b.Duration()
-			c.debug("reconnect: error while reconnecting. try in %s", delay.Round(time.Millisecond))
+			c.log().Warn("reconnect: error while reconnecting", "error", err, "delay", delay.Round(time.Millisecond))
 			time.Sleep(delay)
 			continue
 		}
@@ -277,28 +416,92 @@ func (c *client) GetReconnectCount() int64 {
 func NewRequestList() RequestList {
 	return RequestList{
 		mu:       sync.Mutex{},
-		requests: make(map[string]struct{}), // TODO preallocate buckets
+		requests: make(map[string]chan callResult), // TODO preallocate buckets
 	}
 }
 
-// RequestList state of requests that was sent/received
+// RequestList state of requests that was sent/received, the requests of Write and WriteSync share the ids.
+// A synchronous call waits for its response on its channel, the responses of the other requests are read from the read channel.
 type RequestList struct {
 	mu       sync.Mutex
-	requests map[string]struct{}
+	requests map[string]chan callResult
+	// lostErr is set from the connection loss until the list is recreated for the new connection
+	lostErr error
 }
 
 // Add adds request into list
 func (l *RequestList) Add(id string) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	l.requests[id] = struct{}{}
+	l.requests[id] = nil
+}
+
+// add adds the request unless its id is already in the list, responseC is nil for an asynchronous request
+func (l *RequestList) add(id string, responseC chan callResult) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.requests[id]; ok {
+		return false
+	}
+	l.requests[id] = responseC
+	return true
+}
+
+// addCall adds the synchronous call, it fails with a *ConnectionLostError while the connection is being restored
+func (l *RequestList) addCall(id string, responseC chan callResult) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.lostErr != nil {
+		return l.lostErr
+	}
+	if _, ok := l.requests[id]; ok {
+		return ErrorWsIdAlreadySent
+	}
+	l.requests[id] = responseC
+	return nil
+}
+
+// removeCall removes the synchronous call, unless its id has been reused since
+func (l *RequestList) removeCall(id string, responseC chan callResult) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.requests[id] == responseC {
+		delete(l.requests, id)
+	}
+}
+
+// resolveCall delivers the response to the synchronous call with the same id, if any
+func (l *RequestList) resolveCall(id string, data []byte) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	responseC := l.requests[id]
+	if responseC == nil {
+		return false
+	}
+	delete(l.requests, id)
+	responseC <- callResult{data: data}
+	return true
+}
+
+// failCalls fails all synchronous calls with err, the new ones fail with err until the list is recreated
+func (l *RequestList) failCalls(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lostErr = err
+	for id, responseC := range l.requests {
+		if responseC != nil {
+			responseC <- callResult{err: err}
+			delete(l.requests, id)
+		}
+	}
 }
 
-// RecreateList creates new request list
+// RecreateList creates new request list for the new connection, the synchronous calls are accepted again
 func (l *RequestList) RecreateList() {
 	l.mu.Lock()
 	defer l.mu.Unlock()
-	l.requests = make(map[string]struct{})
+	l.requests = make(map[string]chan callResult)
+	l.lostErr = nil
 }
 
 // Remove adds request from list
@@ -4,14 +4,20 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 type testApiRequest struct {
@@ -36,32 +42,7 @@ func TestClient(t *testing.T) {
 }
 
 func (s *clientTestSuite) TestReadWriteSync() {
-	stopCh := make(chan struct{})
-	go func() {
-		startWsTestServer(stopCh)
-	}()
-	defer func() {
-		stopCh <- struct{}{}
-	}()
-
-	conn, err := NewConnection(func() (*websocket.Conn, error) {
-		Dialer := websocket.Dialer{
-			Proxy:             http.ProxyFromEnvironment,
-			HandshakeTimeout:  45 * time.Second,
-			EnableCompression: false,
-		}
-
-		c, _, err := Dialer.Dial("ws://localhost:8080/ws", nil)
-		if err != nil {
-			return nil, err
-		}
-
-		return c, nil
-	}, true, 10*time.Second)
-	s.Require().NoError(err)
-
-	client, err := NewClient(conn)
-	s.Require().NoError(err)
+	client := s.newTestClient()
 
 	tests := []struct {
 		name         string
@@ -102,7 +83,9 @@ func (s *clientTestSuite) TestReadWriteSync() {
 				reqRaw, err := json.Marshal(req)
 				s.Require().NoError(err)
 
-				err = client.Write(requestID, reqRaw)
+				otherID, err := uuid.NewRandom()
+				s.Require().NoError(err)
+				err = client.Write(otherID.String(), reqRaw)
 				s.Require().NoError(err)
 
 				req = testApiRequest{
@@ -118,6 +101,29 @@ func (s *clientTestSuite) TestReadWriteSync() {
 				s.Require().Equal(reqRaw, responseRaw)
 			},
 		},
+		{
+			name: "WriteSync id already sent by Write",
+			testCallback: func() {
+				id, err := uuid.NewRandom()
+				s.Require().NoError(err)
+				requestID := id.String()
+
+				req := testApiRequest{
+					Id:     "some-other-request-id",
+					Method: "some-method",
+					Params: map[string]interface{}{},
+				}
+				reqRaw, err := json.Marshal(req)
+				s.Require().NoError(err)
+
+				err = client.Write(requestID, reqRaw)
+				s.Require().NoError(err)
+
+				responseRaw, err := client.WriteSync(requestID, reqRaw, 500*time.Millisecond)
+				s.Require().Nil(responseRaw)
+				s.Require().ErrorIs(err, ErrorWsIdAlreadySent)
+			},
+		},
 		{
 			name: "WriteSync timeout expired",
 			testCallback: func() {
@@ -181,6 +187,255 @@ func (s *clientTestSuite) TestReadWriteSync() {
 	}
 }
 
+func (s *clientTestSuite) newRequest(id string, params map[string]interface{}) []byte {
+	reqRaw, err := json.Marshal(testApiRequest{
+		Id:     id,
+		Method: "some-method",
+		Params: params,
+	})
+	s.Require().NoError(err)
+	return reqRaw
+}
+
+func (s *clientTestSuite) TestWriteSyncContext_Concurrent() {
+	client := s.newTestClient()
+
+	const calls = 50
+	wg := sync.WaitGroup{}
+	errC := make(chan error, calls)
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			requestID := fmt.Sprintf("request-%d", i)
+			reqRaw := s.newRequest(requestID, map[string]interface{}{})
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			responseRaw, err := client.WriteSyncContext(ctx, requestID, reqRaw)
+			if err != nil {
+				errC <- err
+				return
+			}
+			if string(responseRaw) != string(reqRaw) {
+				errC <- fmt.Errorf("wrong response for %s: %s", requestID, responseRaw)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errC)
+
+	for err := range errC {
+		s.NoError(err)
+	}
+}
+
+func (s *clientTestSuite) TestWriteSyncContext_WithWrite() {
+	client := s.newTestClient()
+
+	// responses of Write are delivered into the read channel, not to the synchronous call
+	err := client.Write("async-1", s.newRequest("async-1", map[string]interface{}{}))
+	s.Require().NoError(err)
+
+	syncReq := s.newRequest("sync-1", map[string]interface{}{})
+	responseC := make(chan []byte, 1)
+	go func() {
+		responseRaw, err := client.WriteSyncContext(context.Background(), "sync-1", syncReq)
+		s.NoError(err)
+		responseC <- responseRaw
+	}()
+
+	select {
+	case responseRaw := <-client.GetReadChannel():
+		s.Contains(string(responseRaw), "async-1")
+	case <-time.After(5 * time.Second):
+		s.T().Fatal("timeout waiting for async response")
+	}
+
+	select {
+	case responseRaw := <-responseC:
+		s.Equal(syncReq, responseRaw)
+	case <-time.After(5 * time.Second):
+		s.T().Fatal("timeout waiting for sync response")
+	}
+}
+
+func (s *clientTestSuite) TestWriteSyncContext_Canceled() {
+	client := s.newTestClient()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+
+	responseRaw, err := client.WriteSyncContext(ctx, "request-1", s.newRequest("request-1", map[string]interface{}{
+		"timeout": "true",
+	}))
+	s.Nil(responseRaw)
+	s.ErrorIs(err, context.Canceled)
+
+	// the id can be used again once the call returned
+	responseRaw, err = client.WriteSyncContext(context.Background(), "request-1", s.newRequest("request-1", map[string]interface{}{}))
+	s.NoError(err)
+	s.NotNil(responseRaw)
+}
+
+// testLogger records the messages and arguments of the debug logs
+type testLogger struct {
+	mu   sync.Mutex
+	logs []string
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.logs = append(l.logs, fmt.Sprint(msg, args))
+}
+
+func (l *testLogger) Info(msg string, args ...interface{})  {}
+func (l *testLogger) Warn(msg string, args ...interface{})  {}
+func (l *testLogger) Error(msg string, args ...interface{}) {}
+
+func (s *clientTestSuite) TestSetLogger() {
+	client, ok := s.newTestClient().(ConfigurableClient)
+	s.Require().True(ok)
+	logger := new(testLogger)
+	client.SetLogger(logger)
+
+	_, err := client.WriteSync("request-1", s.newRequest("request-1", map[string]interface{}{
+		"apiKey":    s.apiKey,
+		"signature": "dummySignature",
+	}), 5*time.Second)
+	s.Require().NoError(err)
+
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+	s.Contains(logger.logs, `write: sending message[id request-1 request {"id":"request-1","method":"some-method","params":{"apiKey":"REDACTED","signature":"REDACTED"}}]`)
+	for _, l := range logger.logs {
+		s.NotContains(l, s.apiKey)
+		s.NotContains(l, "dummySignature")
+	}
+}
+
+func (s *clientTestSuite) TestSetUsedLimits() {
+	client, ok := s.newTestClient().(ConfigurableClient)
+	s.Require().True(ok)
+	usedLimits := common.NewUsedLimits()
+	client.SetUsedLimits(usedLimits)
+
+	// the test server echoes the request
+	_, err := client.WriteSync("request-1", []byte(`{"id":"request-1","rateLimits":[`+
+		`{"rateLimitType":"REQUEST_WEIGHT","interval":"MINUTE","intervalNum":1,"limit":2400,"count":10},`+
+		`{"rateLimitType":"ORDERS","interval":"SECOND","intervalNum":10,"limit":300,"count":1}]}`), 5*time.Second)
+	s.Require().NoError(err)
+
+	snapshot := usedLimits.Snapshot()
+	s.Equal(int64(10), snapshot.UsedWeight["1M"].Used)
+	s.Equal(int64(1), snapshot.OrderCount["10S"].Used)
+}
+
+func (s *clientTestSuite) TestWriteSyncContext_ConnectionLost() {
+	client := s.newTestClient()
+
+	pendingErrC := make(chan error, 1)
+	go func() {
+		_, err := client.WriteSyncContext(context.Background(), "pending-1", s.newRequest("pending-1", map[string]interface{}{
+			"timeout": "true",
+		}))
+		pendingErrC <- err
+	}()
+	// wait for the pending call to be sent before the connection is closed
+	time.Sleep(100 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := client.WriteSyncContext(ctx, "close-1", s.newRequest("close-1", map[string]interface{}{
+		"close": "true",
+	}))
+	var connectionLostErr *ConnectionLostError
+	s.Require().True(errors.As(err, &connectionLostErr), "unexpected error %v", err)
+	s.ErrorIs(err, ErrorWsConnectionLost)
+
+	select {
+	case err := <-pendingErrC:
+		s.ErrorIs(err, ErrorWsConnectionLost)
+	case <-time.After(5 * time.Second):
+		s.T().Fatal("pending call has not failed")
+	}
+
+	// the client reconnects and serves new calls
+	s.Eventually(func() bool {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		requestID := fmt.Sprintf("after-%d", time.Now().UnixNano())
+		_, err := client.WriteSyncContext(ctx, requestID, s.newRequest(requestID, map[string]interface{}{}))
+		return err == nil
+	}, 5*time.Second, 100*time.Millisecond)
+	s.GreaterOrEqual(client.GetReconnectCount(), int64(1))
+}
+
+func (s *clientTestSuite) TestWriteSyncContext_DuringReconnect() {
+	conn := newTestConnection()
+	c, err := NewClient(conn)
+	s.Require().NoError(err)
+	client := c.(ContextClient)
+
+	conn.readErrC <- errors.New("connection reset by peer")
+	// the reconnect is pending until a connection is sent into restoreC
+	s.Require().Eventually(func() bool { return client.GetReconnectCount() >= 1 }, 5*time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	start := time.Now()
+	_, err = client.WriteSyncContext(ctx, "lost-1", s.newRequest("lost-1", map[string]interface{}{}))
+	var connectionLostErr *ConnectionLostError
+	s.Require().True(errors.As(err, &connectionLostErr), "unexpected error %v", err)
+	s.Less(time.Since(start), time.Second)
+
+	newConn := newTestConnection()
+	conn.restoreC <- newConn
+	s.Eventually(func() bool {
+		requestID := fmt.Sprintf("after-%d", time.Now().UnixNano())
+		_, err := client.WriteSyncContext(ctx, requestID, s.newRequest(requestID, map[string]interface{}{}))
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// testConnection echoes the messages written, ReadMessage fails with the errors sent into readErrC
+// and RestoreConnection waits for the connection sent into restoreC
+type testConnection struct {
+	messages chan []byte
+	readErrC chan error
+	restoreC chan Connection
+}
+
+func newTestConnection() *testConnection {
+	return &testConnection{
+		messages: make(chan []byte, 10),
+		readErrC: make(chan error, 1),
+		restoreC: make(chan Connection),
+	}
+}
+
+func (c *testConnection) WriteMessage(messageType int, data []byte) error {
+	c.messages <- data
+	return nil
+}
+
+func (c *testConnection) ReadMessage() (int, []byte, error) {
+	select {
+	case data := <-c.messages:
+		return websocket.TextMessage, data, nil
+	case err := <-c.readErrC:
+		return 0, nil, err
+	}
+}
+
+func (c *testConnection) RestoreConnection() (Connection, error) {
+	return <-c.restoreC, nil
+}
+
 var upgrader = websocket.Upgrader{
 	CheckOrigin: func(r *http.Request) bool {
 		return true
@@ -223,6 +478,10 @@ func wsHandler(w http.ResponseWriter, r *http.Request) {
 			continue
 		}
 
+		if req.Params["close"] == "true" {
+			break
+		}
+
 		err = conn.WriteMessage(messageType, message)
 		if err != nil {
 			log.Println("Error writing message:", err)
@@ -231,28 +490,39 @@ func wsHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-func startWsTestServer(stopCh chan struct{}) {
-	server := &http.Server{
-		Addr: ":8080",
-	}
+// startWsTestServer starts an echo websocket server listening before it returns
+func startWsTestServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ws", wsHandler)
+	return httptest.NewServer(mux)
+}
 
-	http.HandleFunc("/ws", wsHandler)
-	log.Println("WebSocket server started on :8080")
+// newTestClient connects a client to a new test server, the server is closed at the end of the test
+func (s *clientTestSuite) newTestClient() ContextClient {
+	server := startWsTestServer()
+	s.T().Cleanup(server.Close)
 
-	go func() {
-		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
-			log.Fatalf("WebSocket server error: %v", err)
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws"
+	conn, err := NewConnection(func() (*websocket.Conn, error) {
+		Dialer := websocket.Dialer{
+			Proxy:             http.ProxyFromEnvironment,
+			HandshakeTimeout:  45 * time.Second,
+			EnableCompression: false,
 		}
-		log.Println("Stopped serving new connections.")
-	}()
 
-	<-stopCh
+		c, _, err := Dialer.Dial(url, nil)
+		if err != nil {
+			return nil, err
+		}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+		return c, nil
+	}, true, 10*time.Second)
+	s.Require().NoError(err)
 
-	if err := server.Shutdown(ctx); err != nil {
-		log.Fatalf("WebSocket shutdown error: %v", err)
-	}
-	log.Println("Graceful shutdown complete.")
+	client, err := NewClient(conn)
+	s.Require().NoError(err)
+
+	contextClient, ok := client.(ContextClient)
+	s.Require().True(ok)
+	return contextClient
 }
@@ -5,9 +5,11 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
 	time "time"
 
+	common "github.com/adshao/go-binance/v2/common"
 	websocket "github.com/adshao/go-binance/v2/common/websocket"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -118,6 +120,269 @@ func (mr *MockClientMockRecorder) WriteSync(id, data, timeout interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteSync", reflect.TypeOf((*MockClient)(nil).WriteSync), id, data, timeout)
 }
 
+// MockContextClient is a mock of ContextClient interface.
+type MockContextClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockContextClientMockRecorder
+}
+
+// MockContextClientMockRecorder is the mock recorder for MockContextClient.
+type MockContextClientMockRecorder struct {
+	mock *MockContextClient
+}
+
+// NewMockContextClient creates a new mock instance.
+func NewMockContextClient(ctrl *gomock.Controller) *MockContextClient {
+	mock := &MockContextClient{ctrl: ctrl}
+	mock.recorder = &MockContextClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContextClient) EXPECT() *MockContextClientMockRecorder {
+	return m.recorder
+}
+
+// GetReadChannel mocks base method.
+func (m *MockContextClient) GetReadChannel() <-chan []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadChannel")
+	ret0, _ := ret[0].(<-chan []byte)
+	return ret0
+}
+
+// GetReadChannel indicates an expected call of GetReadChannel.
+func (mr *MockContextClientMockRecorder) GetReadChannel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadChannel", reflect.TypeOf((*MockContextClient)(nil).GetReadChannel))
+}
+
+// GetReadErrorChannel mocks base method.
+func (m *MockContextClient) GetReadErrorChannel() <-chan error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadErrorChannel")
+	ret0, _ := ret[0].(<-chan error)
+	return ret0
+}
+
+// GetReadErrorChannel indicates an expected call of GetReadErrorChannel.
+func (mr *MockContextClientMockRecorder) GetReadErrorChannel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadErrorChannel", reflect.TypeOf((*MockContextClient)(nil).GetReadErrorChannel))
+}
+
+// GetReconnectCount mocks base method.
+func (m *MockContextClient) GetReconnectCount() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReconnectCount")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetReconnectCount indicates an expected call of GetReconnectCount.
+func (mr *MockContextClientMockRecorder) GetReconnectCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconnectCount", reflect.TypeOf((*MockContextClient)(nil).GetReconnectCount))
+}
+
+// Wait mocks base method.
+func (m *MockContextClient) Wait(timeout time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Wait", timeout)
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockContextClientMockRecorder) Wait(timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockContextClient)(nil).Wait), timeout)
+}
+
+// Write mocks base method.
+func (m *MockContextClient) Write(id string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", id, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockContextClientMockRecorder) Write(id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockContextClient)(nil).Write), id, data)
+}
+
+// WriteSync mocks base method.
+func (m *MockContextClient) WriteSync(id string, data []byte, timeout time.Duration) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteSync", id, data, timeout)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteSync indicates an expected call of WriteSync.
+func (mr *MockContextClientMockRecorder) WriteSync(id, data, timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteSync", reflect.TypeOf((*MockContextClient)(nil).WriteSync), id, data, timeout)
+}
+
+// WriteSyncContext mocks base method.
+func (m *MockContextClient) WriteSyncContext(ctx context.Context, id string, data []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteSyncContext", ctx, id, data)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteSyncContext indicates an expected call of WriteSyncContext.
+func (mr *MockContextClientMockRecorder) WriteSyncContext(ctx, id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteSyncContext", reflect.TypeOf((*MockContextClient)(nil).WriteSyncContext), ctx, id, data)
+}
+
+// MockConfigurableClient is a mock of ConfigurableClient interface.
+type MockConfigurableClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigurableClientMockRecorder
+}
+
+// MockConfigurableClientMockRecorder is the mock recorder for MockConfigurableClient.
+type MockConfigurableClientMockRecorder struct {
+	mock *MockConfigurableClient
+}
+
+// NewMockConfigurableClient creates a new mock instance.
+func NewMockConfigurableClient(ctrl *gomock.Controller) *MockConfigurableClient {
+	mock := &MockConfigurableClient{ctrl: ctrl}
+	mock.recorder = &MockConfigurableClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigurableClient) EXPECT() *MockConfigurableClientMockRecorder {
+	return m.recorder
+}
+
+// GetReadChannel mocks base method.
+func (m *MockConfigurableClient) GetReadChannel() <-chan []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadChannel")
+	ret0, _ := ret[0].(<-chan []byte)
+	return ret0
+}
+
+// GetReadChannel indicates an expected call of GetReadChannel.
+func (mr *MockConfigurableClientMockRecorder) GetReadChannel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadChannel", reflect.TypeOf((*MockConfigurableClient)(nil).GetReadChannel))
+}
+
+// GetReadErrorChannel mocks base method.
+func (m *MockConfigurableClient) GetReadErrorChannel() <-chan error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadErrorChannel")
+	ret0, _ := ret[0].(<-chan error)
+	return ret0
+}
+
+// GetReadErrorChannel indicates an expected call of GetReadErrorChannel.
+func (mr *MockConfigurableClientMockRecorder) GetReadErrorChannel() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadErrorChannel", reflect.TypeOf((*MockConfigurableClient)(nil).GetReadErrorChannel))
+}
+
+// GetReconnectCount mocks base method.
+func (m *MockConfigurableClient) GetReconnectCount() int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReconnectCount")
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// GetReconnectCount indicates an expected call of GetReconnectCount.
+func (mr *MockConfigurableClientMockRecorder) GetReconnectCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconnectCount", reflect.TypeOf((*MockConfigurableClient)(nil).GetReconnectCount))
+}
+
+// OnReconnect mocks base method.
+func (m *MockConfigurableClient) OnReconnect(handler func()) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnReconnect", handler)
+}
+
+// OnReconnect indicates an expected call of OnReconnect.
+func (mr *MockConfigurableClientMockRecorder) OnReconnect(handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnReconnect", reflect.TypeOf((*MockConfigurableClient)(nil).OnReconnect), handler)
+}
+
+// SetLogger mocks base method.
+func (m *MockConfigurableClient) SetLogger(logger common.Logger) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLogger", logger)
+}
+
+// SetLogger indicates an expected call of SetLogger.
+func (mr *MockConfigurableClientMockRecorder) SetLogger(logger interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLogger", reflect.TypeOf((*MockConfigurableClient)(nil).SetLogger), logger)
+}
+
+// SetUsedLimits mocks base method.
+func (m *MockConfigurableClient) SetUsedLimits(usedLimits *common.UsedLimits) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetUsedLimits", usedLimits)
+}
+
+// SetUsedLimits indicates an expected call of SetUsedLimits.
+func (mr *MockConfigurableClientMockRecorder) SetUsedLimits(usedLimits interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUsedLimits", reflect.TypeOf((*MockConfigurableClient)(nil).SetUsedLimits), usedLimits)
+}
+
+// Wait mocks base method.
+func (m *MockConfigurableClient) Wait(timeout time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Wait", timeout)
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockConfigurableClientMockRecorder) Wait(timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockConfigurableClient)(nil).Wait), timeout)
+}
+
+// Write mocks base method.
+func (m *MockConfigurableClient) Write(id string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Write", id, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Write indicates an expected call of Write.
+func (mr *MockConfigurableClientMockRecorder) Write(id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockConfigurableClient)(nil).Write), id, data)
+}
+
+// WriteSync mocks base method.
+func (m *MockConfigurableClient) WriteSync(id string, data []byte, timeout time.Duration) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteSync", id, data, timeout)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteSync indicates an expected call of WriteSync.
+func (mr *MockConfigurableClientMockRecorder) WriteSync(id, data, timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteSync", reflect.TypeOf((*MockConfigurableClient)(nil).WriteSync), id, data, timeout)
+}
+
 // MockConnection is a mock of Connection interface.
 type MockConnection struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,170 @@
+package websocket
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrorSessionKeyTypeNotSupported defines that session logon is only supported with ED25519 keys
+	ErrorSessionKeyTypeNotSupported = errors.New("ws service: session logon requires an ED25519 key")
+)
+
+// SessionResult define the authentication status of the connection
+type SessionResult struct {
+	ApiKey           *string `json:"apiKey"`
+	AuthorizedSince  *int64  `json:"authorizedSince"`
+	ConnectedSince   int64   `json:"connectedSince"`
+	ReturnRateLimits bool    `json:"returnRateLimits"`
+	ServerTime       int64   `json:"serverTime"`
+	UserDataStream   bool    `json:"userDataStream"`
+}
+
+// SessionResponse define 'session.logon', 'session.status' and 'session.logout' websocket API response
+type SessionResponse struct {
+	Id     string        `json:"id"`
+	Status int           `json:"status"`
+	Result SessionResult `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// Session authenticates a websocket API connection with 'session.logon'.
+// Once logged on, signed requests are sent with a timestamp only, without api key nor signature.
+// The session is logged on again after the client restores the connection.
+type Session struct {
+	// ErrHandler is called when the session can not be logged on again after a reconnect
+	ErrHandler func(err error)
+
+	c        Client
+	mu       sync.Mutex
+	loggedOn bool
+	// reqData holds the credentials of the last logon, nil if the session has been logged out
+	reqData *RequestData
+}
+
+// NewSession init Session on the client connection.
+// The session is logged on again after a reconnect only if c implements ConfigurableClient.
+func NewSession(c Client) *Session {
+	s := &Session{c: c}
+	if cc, ok := c.(ConfigurableClient); ok {
+		cc.OnReconnect(s.handleReconnect)
+	}
+	return s
+}
+
+// Logon authenticates the connection, only ED25519 keys are supported
+func (s *Session) Logon(reqData RequestData) (*SessionResponse, error) {
+	if reqData.keyType != common.KeyTypeEd25519 {
+		return nil, ErrorSessionKeyTypeNotSupported
+	}
+
+	rawData, err := CreateRequest(reqData, SessionLogonWsApiMethod, map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.writeSync(reqData.requestID, rawData)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Error == nil {
+		s.mu.Lock()
+		s.loggedOn = true
+		s.reqData = &reqData
+		s.mu.Unlock()
+	}
+
+	return response, nil
+}
+
+// Status returns the authentication status of the connection
+func (s *Session) Status(requestID string) (*SessionResponse, error) {
+	rawData, err := CreateUnsignedRequest(requestID, SessionStatusWsApiMethod, map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.writeSync(requestID, rawData)
+}
+
+// Logout forgets the api key of the connection, signed requests are signed again
+func (s *Session) Logout(requestID string) (*SessionResponse, error) {
+	rawData, err := CreateUnsignedRequest(requestID, SessionLogoutWsApiMethod, map[string]interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.writeSync(requestID, rawData)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Error == nil {
+		s.mu.Lock()
+		s.loggedOn = false
+		s.reqData = nil
+		s.mu.Unlock()
+	}
+
+	return response, nil
+}
+
+// IsLoggedOn checks if the connection is authenticated
+func (s *Session) IsLoggedOn() bool {
+	if s == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.loggedOn
+}
+
+// CreateRequest creates a session request if the session is logged on, otherwise a signed request
+func (s *Session) CreateRequest(reqData RequestData, method WsApiMethodType, params map[string]interface{}) ([]byte, error) {
+	if s.IsLoggedOn() {
+		return CreateSessionRequest(reqData, method, params)
+	}
+	return CreateRequest(reqData, method, params)
+}
+
+func (s *Session) writeSync(requestID string, rawData []byte) (*SessionResponse, error) {
+	data, err := s.c.WriteSync(requestID, rawData, WriteSyncWsTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SessionResponse{}
+	if err := json.Unmarshal(data, response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// handleReconnect logs on again with the credentials of the last logon, the new connection is not authenticated
+func (s *Session) handleReconnect() {
+	s.mu.Lock()
+	s.loggedOn = false
+	if s.reqData == nil {
+		s.mu.Unlock()
+		return
+	}
+	reqData := *s.reqData
+	s.mu.Unlock()
+
+	reqData.requestID = uuid.New().String()
+	response, err := s.Logon(reqData)
+	if err == nil && response.Error != nil {
+		err = response.Error
+	}
+	if err != nil && s.ErrHandler != nil {
+		s.ErrHandler(err)
+	}
+}
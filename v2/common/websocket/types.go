@@ -35,11 +35,58 @@ const (
 	// signatureKey define key for websocket API parameters
 	signatureKey = "signature"
 
+	// SESSION
+
+	// SessionLogonWsApiMethod define method for authentication of the connection via websocket API
+	SessionLogonWsApiMethod WsApiMethodType = "session.logon"
+
+	// SessionStatusWsApiMethod define method for query the authentication status of the connection via websocket API
+	SessionStatusWsApiMethod WsApiMethodType = "session.status"
+
+	// SessionLogoutWsApiMethod define method for forget the authenticated api key of the connection via websocket API
+	SessionLogoutWsApiMethod WsApiMethodType = "session.logout"
+
 	// SPOT
 
 	// OrderPlaceSpotWsApiMethod define method for creation order via websocket API
 	OrderPlaceSpotWsApiMethod WsApiMethodType = "order.place"
 
+	// OrderCancelSpotWsApiMethod define method for cancel order via websocket API
+	OrderCancelSpotWsApiMethod WsApiMethodType = "order.cancel"
+
+	// OrderStatusSpotWsApiMethod define method for query order via websocket API
+	OrderStatusSpotWsApiMethod WsApiMethodType = "order.status"
+
+	// OrderCancelReplaceSpotWsApiMethod define method for cancel an order and place a new one via websocket API
+	OrderCancelReplaceSpotWsApiMethod WsApiMethodType = "order.cancelReplace"
+
+	// OpenOrdersStatusSpotWsApiMethod define method for query open orders via websocket API
+	OpenOrdersStatusSpotWsApiMethod WsApiMethodType = "openOrders.status"
+
+	// OpenOrdersCancelAllSpotWsApiMethod define method for cancel all open orders on a symbol via websocket API
+	OpenOrdersCancelAllSpotWsApiMethod WsApiMethodType = "openOrders.cancelAll"
+
+	// OrderListPlaceOCOSpotWsApiMethod define method for creation OCO order list via websocket API
+	OrderListPlaceOCOSpotWsApiMethod WsApiMethodType = "orderList.place.oco"
+
+	// AccountStatusSpotWsApiMethod define method for query account information via websocket API
+	AccountStatusSpotWsApiMethod WsApiMethodType = "account.status"
+
+	// MyTradesSpotWsApiMethod define method for query account trade list via websocket API
+	MyTradesSpotWsApiMethod WsApiMethodType = "myTrades"
+
+	// DepthSpotWsApiMethod define method for query order book via websocket API
+	DepthSpotWsApiMethod WsApiMethodType = "depth"
+
+	// KlinesSpotWsApiMethod define method for query klines via websocket API
+	KlinesSpotWsApiMethod WsApiMethodType = "klines"
+
+	// TickerPriceSpotWsApiMethod define method for query latest price via websocket API
+	TickerPriceSpotWsApiMethod WsApiMethodType = "ticker.price"
+
+	// ExchangeInfoSpotWsApiMethod define method for query exchange information via websocket API
+	ExchangeInfoSpotWsApiMethod WsApiMethodType = "exchangeInfo"
+
 	// FUTURES
 
 	// OrderPlaceFuturesWsApiMethod define method for creation order via websocket API
@@ -125,6 +172,37 @@ func CreateRequest(reqData RequestData, method WsApiMethodType, params map[strin
 	return rawData, nil
 }
 
+// CreateSessionRequest creates ws request for an authenticated session, only the timestamp is added
+func CreateSessionRequest(reqData RequestData, method WsApiMethodType, params map[string]interface{}) ([]byte, error) {
+	if reqData.requestID == "" {
+		return nil, ErrorRequestIDNotSet
+	}
+
+	params[timestampKey] = timestamp(reqData.timeOffset)
+
+	return CreateUnsignedRequest(reqData.requestID, method, params)
+}
+
+// CreateUnsignedRequest creates ws request without api key and signature, e.g. for market data methods
+func CreateUnsignedRequest(requestID string, method WsApiMethodType, params map[string]interface{}) ([]byte, error) {
+	if requestID == "" {
+		return nil, ErrorRequestIDNotSet
+	}
+
+	req := WsApiRequest{
+		Id:     requestID,
+		Method: method,
+		Params: params,
+	}
+
+	rawData, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rawData, nil
+}
+
 // encode encodes the parameters to a URL encoded string
 func encodeParams(p map[string]interface{}) string {
 	queryValues := url.Values{}
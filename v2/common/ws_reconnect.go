@@ -0,0 +1,196 @@
+package common
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jpillora/backoff"
+)
+
+// ErrorWsConnectionAge is the reason of a reconnection made before the connection reached MaxConnectionAge
+var ErrorWsConnectionAge = errors.New("websocket: max connection age reached")
+
+// WsState define the state of a reconnecting websocket stream
+type WsState string
+
+const (
+	WsStateConnected    WsState = "CONNECTED"
+	WsStateDisconnected WsState = "DISCONNECTED"
+	WsStateReconnecting WsState = "RECONNECTING"
+)
+
+// WsStateEvent is reported on each state change of a reconnecting websocket stream
+type WsStateEvent struct {
+	State    WsState
+	Endpoint string
+	// Attempt is the number of the reconnection attempt, zero for the first connection
+	Attempt int
+	// Err is the reason of the disconnection or of the reconnection
+	Err error
+}
+
+// WsServeFunc connects once to a websocket stream, doneC is closed when the connection is lost
+type WsServeFunc func(errHandler func(err error)) (doneC, stopC chan struct{}, err error)
+
+// WsReconnectPolicy define how a websocket stream is reconnected when the connection is lost.
+// The stream is connected again to the same endpoint, so the streams it subscribes to are resubscribed.
+type WsReconnectPolicy struct {
+	// MinBackoff and MaxBackoff bound the exponential backoff between two reconnection attempts
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	Factor     float64
+	Jitter     bool
+	// MaxRetries is the max number of consecutive failed reconnection attempts, zero means no max
+	MaxRetries int
+	// MaxConnectionAge after which the connection is replaced by a new one, zero disables it.
+	// Binance closes the connections after 24 hours, the new connection is opened before the old one is closed
+	// so a few messages may be received twice.
+	MaxConnectionAge time.Duration
+	// StateHandler is called on each state change, it must not block
+	StateHandler func(event *WsStateEvent)
+}
+
+// NewWsReconnectPolicy init a WsReconnectPolicy with the default settings
+func NewWsReconnectPolicy() *WsReconnectPolicy {
+	return &WsReconnectPolicy{
+		MinBackoff:       500 * time.Millisecond,
+		MaxBackoff:       time.Minute,
+		Factor:           2,
+		Jitter:           true,
+		MaxConnectionAge: 23 * time.Hour,
+	}
+}
+
+// wsConn is a connection of a reconnecting stream
+type wsConn struct {
+	doneC chan struct{}
+	stopC chan struct{}
+	mu    sync.Mutex
+	err   error
+}
+
+func (c *wsConn) lastErr() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+func (c *wsConn) stop() {
+	close(c.stopC)
+	<-c.doneC
+}
+
+// Serve connects to the stream using serve and connects again each time the connection is lost, until stopC is closed.
+// An error is returned if the first connection fails.
+func (p *WsReconnectPolicy) Serve(endpoint string, serve WsServeFunc, errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	conn, err := p.connect(serve, errHandler)
+	if err != nil {
+		return nil, nil, err
+	}
+	p.handleState(&WsStateEvent{State: WsStateConnected, Endpoint: endpoint})
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	go func() {
+		defer close(doneC)
+		p.run(endpoint, serve, errHandler, conn, stopC)
+	}()
+	return doneC, stopC, nil
+}
+
+func (p *WsReconnectPolicy) connect(serve WsServeFunc, errHandler func(err error)) (*wsConn, error) {
+	conn := new(wsConn)
+	doneC, stopC, err := serve(func(err error) {
+		conn.mu.Lock()
+		conn.err = err
+		conn.mu.Unlock()
+		errHandler(err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	conn.doneC = doneC
+	conn.stopC = stopC
+	return conn, nil
+}
+
+func (p *WsReconnectPolicy) run(endpoint string, serve WsServeFunc, errHandler func(err error), conn *wsConn, stopC chan struct{}) {
+	var ageC <-chan time.Time
+	var ageTimer *time.Timer
+	if p.MaxConnectionAge > 0 {
+		ageTimer = time.NewTimer(p.MaxConnectionAge)
+		defer ageTimer.Stop()
+		ageC = ageTimer.C
+	}
+	for {
+		select {
+		case <-stopC:
+			conn.stop()
+			return
+		case <-ageC:
+			// open the new connection first so that no message is missed
+			p.handleState(&WsStateEvent{State: WsStateReconnecting, Endpoint: endpoint, Err: ErrorWsConnectionAge})
+			next, err := p.connect(serve, errHandler)
+			if err != nil {
+				errHandler(err)
+				ageTimer.Reset(p.MinBackoff)
+				continue
+			}
+			conn.stop()
+			conn = next
+			ageTimer.Reset(p.MaxConnectionAge)
+			p.handleState(&WsStateEvent{State: WsStateConnected, Endpoint: endpoint, Err: ErrorWsConnectionAge})
+		case <-conn.doneC:
+			p.handleState(&WsStateEvent{State: WsStateDisconnected, Endpoint: endpoint, Err: conn.lastErr()})
+			var ok bool
+			conn, ok = p.reconnect(endpoint, serve, errHandler, stopC)
+			if !ok {
+				return
+			}
+			if ageTimer != nil {
+				if !ageTimer.Stop() {
+					select {
+					case <-ageTimer.C:
+					default:
+					}
+				}
+				ageTimer.Reset(p.MaxConnectionAge)
+			}
+		}
+	}
+}
+
+// reconnect retries to connect with an exponential backoff,
+// ok is false when stopC is closed or MaxRetries is reached
+func (p *WsReconnectPolicy) reconnect(endpoint string, serve WsServeFunc, errHandler func(err error), stopC chan struct{}) (conn *wsConn, ok bool) {
+	b := &backoff.Backoff{
+		Min:    p.MinBackoff,
+		Max:    p.MaxBackoff,
+		Factor: p.Factor,
+		Jitter: p.Jitter,
+	}
+	for attempt := 1; p.MaxRetries <= 0 || attempt <= p.MaxRetries; attempt++ {
+		p.handleState(&WsStateEvent{State: WsStateReconnecting, Endpoint: endpoint, Attempt: attempt})
+		timer := time.NewTimer(b.Duration())
+		select {
+		case <-stopC:
+			timer.Stop()
+			return nil, false
+		case <-timer.C:
+		}
+		conn, err := p.connect(serve, errHandler)
+		if err != nil {
+			errHandler(err)
+			continue
+		}
+		p.handleState(&WsStateEvent{State: WsStateConnected, Endpoint: endpoint, Attempt: attempt})
+		return conn, true
+	}
+	return nil, false
+}
+
+func (p *WsReconnectPolicy) handleState(event *WsStateEvent) {
+	if p.StateHandler != nil {
+		p.StateHandler(event)
+	}
+}
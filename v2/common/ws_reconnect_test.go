@@ -0,0 +1,160 @@
+package common
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testWsServer serves test connections, the connection attempts fail while err is set
+type testWsServer struct {
+	mu    sync.Mutex
+	err   error
+	conns chan *testWsConn
+}
+
+type testWsConn struct {
+	doneC      chan struct{}
+	stopC      chan struct{}
+	errHandler func(err error)
+}
+
+// drop simulates a lost connection
+func (c *testWsConn) drop(err error) {
+	c.errHandler(err)
+	close(c.doneC)
+}
+
+func newTestWsServer() *testWsServer {
+	return &testWsServer{conns: make(chan *testWsConn, 100)}
+}
+
+func (s *testWsServer) setErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func (s *testWsServer) serve(errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	s.mu.Lock()
+	err = s.err
+	s.mu.Unlock()
+	if err != nil {
+		return nil, nil, err
+	}
+	conn := &testWsConn{
+		doneC:      make(chan struct{}),
+		stopC:      make(chan struct{}),
+		errHandler: errHandler,
+	}
+	go func() {
+		select {
+		case <-conn.stopC:
+			close(conn.doneC)
+		case <-conn.doneC:
+		}
+	}()
+	s.conns <- conn
+	return conn.doneC, conn.stopC, nil
+}
+
+func newTestWsReconnectPolicy(states chan *WsStateEvent) *WsReconnectPolicy {
+	p := NewWsReconnectPolicy()
+	p.MinBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	p.Jitter = false
+	p.StateHandler = func(event *WsStateEvent) {
+		states <- event
+	}
+	return p
+}
+
+func TestWsReconnectPolicyReconnect(t *testing.T) {
+	server := newTestWsServer()
+	states := make(chan *WsStateEvent, 100)
+	p := newTestWsReconnectPolicy(states)
+	errC := make(chan error, 10)
+
+	doneC, stopC, err := p.Serve("endpoint", server.serve, func(err error) {
+		errC <- err
+	})
+	require.NoError(t, err)
+	conn := <-server.conns
+	assert.Equal(t, &WsStateEvent{State: WsStateConnected, Endpoint: "endpoint"}, <-states)
+
+	// the connection is lost and the first attempt fails
+	dialErr := errors.New("dial error")
+	server.setErr(dialErr)
+	readErr := errors.New("read error")
+	conn.drop(readErr)
+	assert.Equal(t, readErr, <-errC)
+	assert.Equal(t, &WsStateEvent{State: WsStateDisconnected, Endpoint: "endpoint", Err: readErr}, <-states)
+	assert.Equal(t, &WsStateEvent{State: WsStateReconnecting, Endpoint: "endpoint", Attempt: 1}, <-states)
+	assert.Equal(t, dialErr, <-errC)
+	server.setErr(nil)
+	assert.Equal(t, &WsStateEvent{State: WsStateReconnecting, Endpoint: "endpoint", Attempt: 2}, <-states)
+	conn = <-server.conns
+	assert.Equal(t, &WsStateEvent{State: WsStateConnected, Endpoint: "endpoint", Attempt: 2}, <-states)
+
+	close(stopC)
+	<-doneC
+	_, ok := <-conn.doneC
+	assert.False(t, ok)
+}
+
+func TestWsReconnectPolicyMaxRetries(t *testing.T) {
+	server := newTestWsServer()
+	states := make(chan *WsStateEvent, 100)
+	p := newTestWsReconnectPolicy(states)
+	p.MaxRetries = 2
+
+	doneC, _, err := p.Serve("endpoint", server.serve, func(err error) {})
+	require.NoError(t, err)
+	conn := <-server.conns
+	server.setErr(errors.New("dial error"))
+	conn.drop(errors.New("read error"))
+	<-doneC
+
+	var reconnecting int
+	for len(states) > 0 {
+		if (<-states).State == WsStateReconnecting {
+			reconnecting++
+		}
+	}
+	assert.Equal(t, 2, reconnecting)
+}
+
+func TestWsReconnectPolicyMaxConnectionAge(t *testing.T) {
+	server := newTestWsServer()
+	states := make(chan *WsStateEvent, 100)
+	p := newTestWsReconnectPolicy(states)
+	p.MaxConnectionAge = 10 * time.Millisecond
+
+	doneC, stopC, err := p.Serve("endpoint", server.serve, func(err error) {})
+	require.NoError(t, err)
+	first := <-server.conns
+	<-states
+
+	// the new connection is opened before the old one is closed
+	second := <-server.conns
+	assert.Equal(t, &WsStateEvent{State: WsStateReconnecting, Endpoint: "endpoint", Err: ErrorWsConnectionAge}, <-states)
+	assert.Equal(t, &WsStateEvent{State: WsStateConnected, Endpoint: "endpoint", Err: ErrorWsConnectionAge}, <-states)
+	_, ok := <-first.doneC
+	assert.False(t, ok)
+
+	close(stopC)
+	<-doneC
+	_, ok = <-second.doneC
+	assert.False(t, ok)
+}
+
+func TestWsReconnectPolicyServeError(t *testing.T) {
+	server := newTestWsServer()
+	server.setErr(errors.New("dial error"))
+	_, _, err := NewWsReconnectPolicy().Serve("endpoint", server.serve, func(err error) {})
+	assert.EqualError(t, err, "dial error")
+}
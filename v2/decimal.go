@@ -0,0 +1,282 @@
+package binance
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (o *Order) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal, see common.ToDecimal
+func (o *Order) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal, see common.ToDecimal
+func (o *Order) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal return CummulativeQuoteQuantity as a decimal, see common.ToDecimal
+func (o *Order) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.CummulativeQuoteQuantity)
+}
+
+// StopPriceDecimal return StopPrice as a decimal, see common.ToDecimal
+func (o *Order) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.StopPrice)
+}
+
+// IcebergQuantityDecimal return IcebergQuantity as a decimal, see common.ToDecimal
+func (o *Order) IcebergQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.IcebergQuantity)
+}
+
+// OrigQuoteOrderQuantityDecimal return OrigQuoteOrderQuantity as a decimal, see common.ToDecimal
+func (o *Order) OrigQuoteOrderQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.OrigQuoteOrderQuantity)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (f *Fill) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(f.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal, see common.ToDecimal
+func (f *Fill) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(f.Quantity)
+}
+
+// CommissionDecimal return Commission as a decimal, see common.ToDecimal
+func (f *Fill) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(f.Commission)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.OrigQuantity)
+}
+
+// OrigQuoteOrderQuantityDecimal return OrigQuoteOrderQuantity as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) OrigQuoteOrderQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.OrigQuoteOrderQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal return CummulativeQuoteQuantity as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) CummulativeQuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.CummulativeQuoteQuantity)
+}
+
+// MarginBuyBorrowAmountDecimal return MarginBuyBorrowAmount as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) MarginBuyBorrowAmountDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.MarginBuyBorrowAmount)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (t *Trade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(t.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal, see common.ToDecimal
+func (t *Trade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(t.Quantity)
+}
+
+// QuoteQuantityDecimal return QuoteQuantity as a decimal, see common.ToDecimal
+func (t *Trade) QuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(t.QuoteQuantity)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (t *TradeV3) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(t.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal, see common.ToDecimal
+func (t *TradeV3) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(t.Quantity)
+}
+
+// QuoteQuantityDecimal return QuoteQuantity as a decimal, see common.ToDecimal
+func (t *TradeV3) QuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(t.QuoteQuantity)
+}
+
+// CommissionDecimal return Commission as a decimal, see common.ToDecimal
+func (t *TradeV3) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(t.Commission)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (a *AggTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal, see common.ToDecimal
+func (a *AggTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Quantity)
+}
+
+// OpenDecimal return Open as a decimal, see common.ToDecimal
+func (k *Kline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.Open)
+}
+
+// HighDecimal return High as a decimal, see common.ToDecimal
+func (k *Kline) HighDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.High)
+}
+
+// LowDecimal return Low as a decimal, see common.ToDecimal
+func (k *Kline) LowDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.Low)
+}
+
+// CloseDecimal return Close as a decimal, see common.ToDecimal
+func (k *Kline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.Close)
+}
+
+// VolumeDecimal return Volume as a decimal, see common.ToDecimal
+func (k *Kline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.Volume)
+}
+
+// QuoteAssetVolumeDecimal return QuoteAssetVolume as a decimal, see common.ToDecimal
+func (k *Kline) QuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.QuoteAssetVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal return TakerBuyBaseAssetVolume as a decimal, see common.ToDecimal
+func (k *Kline) TakerBuyBaseAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal return TakerBuyQuoteAssetVolume as a decimal, see common.ToDecimal
+func (k *Kline) TakerBuyQuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.TakerBuyQuoteAssetVolume)
+}
+
+// OpenDecimal return Open as a decimal, see common.ToDecimal
+func (w *WsKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Open)
+}
+
+// CloseDecimal return Close as a decimal, see common.ToDecimal
+func (w *WsKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Close)
+}
+
+// HighDecimal return High as a decimal, see common.ToDecimal
+func (w *WsKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.High)
+}
+
+// LowDecimal return Low as a decimal, see common.ToDecimal
+func (w *WsKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Low)
+}
+
+// VolumeDecimal return Volume as a decimal, see common.ToDecimal
+func (w *WsKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Volume)
+}
+
+// QuoteVolumeDecimal return QuoteVolume as a decimal, see common.ToDecimal
+func (w *WsKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.QuoteVolume)
+}
+
+// ActiveBuyVolumeDecimal return ActiveBuyVolume as a decimal, see common.ToDecimal
+func (w *WsKline) ActiveBuyVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.ActiveBuyVolume)
+}
+
+// ActiveBuyQuoteVolumeDecimal return ActiveBuyQuoteVolume as a decimal, see common.ToDecimal
+func (w *WsKline) ActiveBuyQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.ActiveBuyQuoteVolume)
+}
+
+// FreeDecimal return Free as a decimal, see common.ToDecimal
+func (b *Balance) FreeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.Free)
+}
+
+// LockedDecimal return Locked as a decimal, see common.ToDecimal
+func (b *Balance) LockedDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.Locked)
+}
+
+// BidPriceDecimal return BidPrice as a decimal, see common.ToDecimal
+func (b *BookTicker) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.BidPrice)
+}
+
+// BidQuantityDecimal return BidQuantity as a decimal, see common.ToDecimal
+func (b *BookTicker) BidQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.BidQuantity)
+}
+
+// AskPriceDecimal return AskPrice as a decimal, see common.ToDecimal
+func (b *BookTicker) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.AskPrice)
+}
+
+// AskQuantityDecimal return AskQuantity as a decimal, see common.ToDecimal
+func (b *BookTicker) AskQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.AskQuantity)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (e *WsTradeEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal, see common.ToDecimal
+func (e *WsTradeEvent) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.Quantity)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (e *WsAggTradeEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal, see common.ToDecimal
+func (e *WsAggTradeEvent) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.Quantity)
+}
+
+// BestBidPriceDecimal return BestBidPrice as a decimal, see common.ToDecimal
+func (e *WsBookTickerEvent) BestBidPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.BestBidPrice)
+}
+
+// BestBidQtyDecimal return BestBidQty as a decimal, see common.ToDecimal
+func (e *WsBookTickerEvent) BestBidQtyDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.BestBidQty)
+}
+
+// BestAskPriceDecimal return BestAskPrice as a decimal, see common.ToDecimal
+func (e *WsBookTickerEvent) BestAskPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.BestAskPrice)
+}
+
+// BestAskQtyDecimal return BestAskQty as a decimal, see common.ToDecimal
+func (e *WsBookTickerEvent) BestAskQtyDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.BestAskQty)
+}
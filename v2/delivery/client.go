@@ -63,6 +63,16 @@ type UserDataEventType string
 // UserDataEventReasonType define reason type for user data event
 type UserDataEventReasonType string
 
+// PriceMatchType define priceMatch type
+// Can't be passed together with price
+type PriceMatchType string
+
+// ContractType define contract type
+type ContractType string
+
+// ForceOrderCloseType define reason type for force order
+type ForceOrderCloseType string
+
 // Endpoints
 var (
 	BaseApiMainUrl    = "https://dapi.binance.com"
@@ -158,6 +168,23 @@ const (
 	UserDataEventReasonTypeOptionsPremiumFee   UserDataEventReasonType = "OPTIONS_PREMIUM_FEE"
 	UserDataEventReasonTypeOptionsSettleProfit UserDataEventReasonType = "OPTIONS_SETTLE_PROFIT"
 
+	PriceMatchTypeOpponent   PriceMatchType = "OPPONENT"
+	PriceMatchTypeOpponent5  PriceMatchType = "OPPONENT_5"
+	PriceMatchTypeOpponent10 PriceMatchType = "OPPONENT_10"
+	PriceMatchTypeOpponent20 PriceMatchType = "OPPONENT_20"
+	PriceMatchTypeQueue      PriceMatchType = "QUEUE"
+	PriceMatchTypeQueue5     PriceMatchType = "QUEUE_5"
+	PriceMatchTypeQueue10    PriceMatchType = "QUEUE_10"
+	PriceMatchTypeQueue20    PriceMatchType = "QUEUE_20"
+	PriceMatchTypeNone       PriceMatchType = "NONE"
+
+	ContractTypePerpetual      ContractType = "PERPETUAL"
+	ContractTypeCurrentQuarter ContractType = "CURRENT_QUARTER"
+	ContractTypeNextQuarter    ContractType = "NEXT_QUARTER"
+
+	ForceOrderCloseTypeLiquidation ForceOrderCloseType = "LIQUIDATION"
+	ForceOrderCloseTypeADL         ForceOrderCloseType = "ADL"
+
 	timestampKey  = "timestamp"
 	signatureKey  = "signature"
 	recvWindowKey = "recvWindow"
@@ -195,6 +222,7 @@ func NewClient(apiKey, secretKey string) *Client {
 		UserAgent:  "Binance/golang",
 		HTTPClient: http.DefaultClient,
 		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		UsedLimits: common.NewUsedLimits(),
 	}
 }
 
@@ -216,7 +244,8 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 		HTTPClient: &http.Client{
 			Transport: tr,
 		},
-		Logger: log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		UsedLimits: common.NewUsedLimits(),
 	}
 }
 
@@ -234,12 +263,39 @@ type Client struct {
 	Logger     *log.Logger
 	TimeOffset int64
 	do         doFunc
-}
 
-func (c *Client) debug(format string, v ...interface{}) {
+	// RateLimiter is an optional client side rate limiter, see NewRateLimiter
+	RateLimiter *common.RateLimiter
+	// RetryPolicy enables retries of failed requests, see common.NewRetryPolicy
+	RetryPolicy *common.RetryPolicy
+	// ClockSync keeps the timestamp of signed requests in sync with the server, see NewClockSync
+	ClockSync *common.ClockSync
+	// Interceptors are called in order around each request, see common.Interceptor
+	Interceptors []common.Interceptor
+	// StructuredLogger receives the logs of the client, the secrets are redacted.
+	// Logger is used at debug level when it is nil and Debug is set.
+	StructuredLogger common.Logger
+	// UsedLimits keeps the used weight and order count reported by the server, see common.UsedLimits
+	UsedLimits *common.UsedLimits
+}
+
+// logger return StructuredLogger, or Logger at debug level if Debug is set
+func (c *Client) logger() common.Logger {
+	if c.StructuredLogger != nil {
+		return c.StructuredLogger
+	}
 	if c.Debug {
-		c.Logger.Printf(format, v...)
+		return common.NewStdLogger(c.Logger, common.LogLevelDebug)
+	}
+	return common.NopLogger
+}
+
+// timeOffset return the offset of ClockSync once synced, TimeOffset otherwise
+func (c *Client) timeOffset() int64 {
+	if offset, ok := c.ClockSync.Offset(); ok {
+		return offset
 	}
+	return c.TimeOffset
 }
 
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
@@ -257,7 +313,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.timeOffset())
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -298,7 +354,8 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if queryString != "" {
 		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
 	}
-	c.debug("full url: %s, body: %s\n", fullURL, bodyString)
+	c.logger().Debug("request", "method", r.method, "url", common.RedactURL(fullURL),
+		"body", common.RedactQuery(bodyString), "header", common.RedactHeader(header))
 
 	r.fullURL = fullURL
 	r.header = header
@@ -307,28 +364,70 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	resynced := false
+	for attempt := 0; ; attempt++ {
+		var res *http.Response
+		data, res, err = c.callAPIOnce(ctx, r, attempt, opts...)
+		// re-issue a request rejected for its timestamp once, after resyncing the clock
+		if !resynced && r.secType == secTypeSigned && c.ClockSync.Resync(ctx, err) {
+			resynced = true
+			opts = nil
+			continue
+		}
+		delay, retry := c.RetryPolicy.Retry(attempt, r.method, res, err)
+		if !retry {
+			return data, err
+		}
+		c.logger().Warn("retry request", "endpoint", r.endpoint, "attempt", attempt, "delay", delay, "error", err)
+		if err = common.Sleep(ctx, delay); err != nil {
+			return []byte{}, err
+		}
+		// request options have been applied by the first attempt
+		opts = nil
+	}
+}
+
+func (c *Client) callAPIOnce(ctx context.Context, r *request, attempt int, opts ...RequestOption) (data []byte, res *http.Response, err error) {
 	err = c.parseRequest(r, opts...)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, nil, err
 	}
 	req, err := http.NewRequest(r.method, r.fullURL, r.body)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, nil, err
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
-	c.debug("request: %#v\n", req)
+	weight, orders := requestCost(r)
+	if c.RateLimiter != nil {
+		if err = c.RateLimiter.Wait(ctx, weight, orders); err != nil {
+			return []byte{}, nil, err
+		}
+	}
+	call := common.NewCall(r.method, r.endpoint, r.query, r.form, weight, orders, attempt)
+	err = common.Invoke(ctx, c.Interceptors, call, func(ctx context.Context) (*http.Response, error) {
+		var sendErr error
+		data, res, sendErr = c.send(req.WithContext(ctx))
+		return res, sendErr
+	})
+	return data, res, err
+}
+
+// send sends the request and reads the response, an API error is returned for 4xx and 5xx responses
+func (c *Client) send(req *http.Request) (data []byte, res *http.Response, err error) {
 	f := c.do
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
-	res, err := f(req)
+	res, err = f(req)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, nil, err
 	}
+	c.RateLimiter.Update(res.Header)
+	c.UsedLimits.Update(res.Header)
 	data, err = io.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, res, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -338,22 +437,20 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 			err = cerr
 		}
 	}()
-	c.debug("response: %#v\n", res)
-	c.debug("response body: %s\n", string(data))
-	c.debug("response status code: %d\n", res.StatusCode)
+	c.logger().Debug("response", "endpoint", req.URL.Path, "status", res.StatusCode, "body", common.RedactedJSON(data))
 
 	if res.StatusCode >= http.StatusBadRequest {
 		apiErr := new(common.APIError)
 		e := json.Unmarshal(data, apiErr)
 		if e != nil {
-			c.debug("failed to unmarshal json: %s\n", e)
+			c.logger().Warn("failed to unmarshal api error", "error", e)
 		}
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, apiErr
+		return nil, res, apiErr
 	}
-	return data, nil
+	return data, res, nil
 }
 
 // SetApiEndpoint set api Endpoint
@@ -377,6 +474,19 @@ func (c *Client) NewSetServerTimeService() *SetServerTimeService {
 	return &SetServerTimeService{c: c}
 }
 
+// NewClockSync init a ClockSync polling the server time, assign it to Client.ClockSync
+// and call Start to keep the timestamp of signed requests in sync in the background
+func (c *Client) NewClockSync() *common.ClockSync {
+	return common.NewClockSync(func(ctx context.Context) (int64, error) {
+		return c.NewServerTimeService().Do(ctx)
+	})
+}
+
+// NewDepthService init depth service
+func (c *Client) NewDepthService() *DepthService {
+	return &DepthService{c: c}
+}
+
 // NewKlinesService init klines service
 func (c *Client) NewKlinesService() *KlinesService {
 	return &KlinesService{c: c}
@@ -437,6 +547,11 @@ func (c *Client) NewCancelAllOpenOrdersService() *CancelAllOpenOrdersService {
 	return &CancelAllOpenOrdersService{c: c}
 }
 
+// NewCountdownCancelAllService init countdown cancel all service
+func (c *Client) NewCountdownCancelAllService() *CountdownCancelAllService {
+	return &CountdownCancelAllService{c: c}
+}
+
 // NewListOpenOrdersService init list open orders service
 func (c *Client) NewListOpenOrdersService() *ListOpenOrdersService {
 	return &ListOpenOrdersService{c: c}
@@ -501,3 +616,128 @@ func (c *Client) NewGetFundingInfoService() *GetFundingInfoService {
 func (c *Client) NewFundingRateService() *FundingRateService {
 	return &FundingRateService{c: c}
 }
+
+// NewCreateBatchOrdersService init creating batch order service
+func (c *Client) NewCreateBatchOrdersService() *CreateBatchOrdersService {
+	return &CreateBatchOrdersService{c: c}
+}
+
+// NewModifyOrderService init modify order service
+func (c *Client) NewModifyOrderService() *ModifyOrderService {
+	return &ModifyOrderService{c: c}
+}
+
+// NewModifyBatchOrdersService init modify batch orders service
+func (c *Client) NewModifyBatchOrdersService() *ModifyBatchOrdersService {
+	return &ModifyBatchOrdersService{c: c}
+}
+
+// NewCancelMultiplesOrdersService init cancel multiple orders service
+func (c *Client) NewCancelMultiplesOrdersService() *CancelMultiplesOrdersService {
+	return &CancelMultiplesOrdersService{c: c}
+}
+
+// NewListUserLiquidationOrdersService init list user's liquidation orders service
+func (c *Client) NewListUserLiquidationOrdersService() *ListUserLiquidationOrdersService {
+	return &ListUserLiquidationOrdersService{c: c}
+}
+
+// NewListAccountTradeService init account trade list service
+func (c *Client) NewListAccountTradeService() *ListAccountTradeService {
+	return &ListAccountTradeService{c: c}
+}
+
+// NewAggTradesService init aggregate trades service
+func (c *Client) NewAggTradesService() *AggTradesService {
+	return &AggTradesService{c: c}
+}
+
+// NewRecentTradesService init recent trades service
+func (c *Client) NewRecentTradesService() *RecentTradesService {
+	return &RecentTradesService{c: c}
+}
+
+// NewHistoricalTradesService init historical trades service
+func (c *Client) NewHistoricalTradesService() *HistoricalTradesService {
+	return &HistoricalTradesService{c: c}
+}
+
+// NewGetIncomeHistoryService init getting income history service
+func (c *Client) NewGetIncomeHistoryService() *GetIncomeHistoryService {
+	return &GetIncomeHistoryService{c: c}
+}
+
+// NewCommissionRateService init commission rate service
+func (c *Client) NewCommissionRateService() *CommissionRateService {
+	return &CommissionRateService{c: c}
+}
+
+// NewGetLeverageBracketService init leverage bracket service
+func (c *Client) NewGetLeverageBracketService() *GetLeverageBracketService {
+	return &GetLeverageBracketService{c: c}
+}
+
+// NewGetADLQuantileService init adl quantile service
+func (c *Client) NewGetADLQuantileService() *GetADLQuantileService {
+	return &GetADLQuantileService{c: c}
+}
+
+// NewPremiumIndexService init premium index service
+func (c *Client) NewPremiumIndexService() *PremiumIndexService {
+	return &PremiumIndexService{c: c}
+}
+
+// NewGetOpenInterestService init open interest service
+func (c *Client) NewGetOpenInterestService() *GetOpenInterestService {
+	return &GetOpenInterestService{c: c}
+}
+
+// NewContinuousKlinesService init continuous klines service
+func (c *Client) NewContinuousKlinesService() *ContinuousKlinesService {
+	return &ContinuousKlinesService{c: c}
+}
+
+// NewIndexPriceKlinesService init index price klines service
+func (c *Client) NewIndexPriceKlinesService() *IndexPriceKlinesService {
+	return &IndexPriceKlinesService{c: c}
+}
+
+// NewMarkPriceKlinesService init mark price klines service
+func (c *Client) NewMarkPriceKlinesService() *MarkPriceKlinesService {
+	return &MarkPriceKlinesService{c: c}
+}
+
+// NewPremiumIndexKlinesService init premium index klines service
+func (c *Client) NewPremiumIndexKlinesService() *PremiumIndexKlinesService {
+	return &PremiumIndexKlinesService{c: c}
+}
+
+// NewOpenInterestStatisticsService init open interest statistics service
+func (c *Client) NewOpenInterestStatisticsService() *OpenInterestStatisticsService {
+	return &OpenInterestStatisticsService{c: c}
+}
+
+// NewTopLongShortAccountRatioService init top long short account ratio service
+func (c *Client) NewTopLongShortAccountRatioService() *TopLongShortAccountRatioService {
+	return &TopLongShortAccountRatioService{c: c}
+}
+
+// NewTopLongShortPositionRatioService init top long short position ratio service
+func (c *Client) NewTopLongShortPositionRatioService() *TopLongShortPositionRatioService {
+	return &TopLongShortPositionRatioService{c: c}
+}
+
+// NewLongShortRatioService init long short ratio service
+func (c *Client) NewLongShortRatioService() *LongShortRatioService {
+	return &LongShortRatioService{c: c}
+}
+
+// NewTakerBuySellVolumeService init taker buy sell volume service
+func (c *Client) NewTakerBuySellVolumeService() *TakerBuySellVolumeService {
+	return &TakerBuySellVolumeService{c: c}
+}
+
+// NewBasisService init basis service
+func (c *Client) NewBasisService() *BasisService {
+	return &BasisService{c: c}
+}
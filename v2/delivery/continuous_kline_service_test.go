@@ -0,0 +1,73 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type continuousKlineServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestContinuousKlineService(t *testing.T) {
+	suite.Run(t, new(continuousKlineServiceTestSuite))
+}
+
+// https://binance-docs.github.io/apidocs/delivery/en/#continuous-contract-kline-candlestick-data
+func (s *continuousKlineServiceTestSuite) TestContinuousKlines() {
+	data := []byte(`[
+		[
+			1591256400000,
+			"9653.29201333",
+			"9654.56401333",
+			"9653.07367333",
+			"9653.07367333",
+			"0",
+			1591256459999,
+			"0",
+			60,
+			"0",
+			"0",
+			"0"
+		]
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	contractType := ContractTypePerpetual
+	interval := "1m"
+	limit := 10
+	startTime := int64(1591256400000)
+	endTime := int64(1591256459999)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":         pair,
+			"contractType": contractType,
+			"interval":     interval,
+			"limit":        limit,
+			"startTime":    startTime,
+			"endTime":      endTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	klines, err := s.client.NewContinuousKlinesService().Pair(pair).ContractType(contractType).
+		Interval(interval).Limit(limit).StartTime(startTime).
+		EndTime(endTime).Do(newContext())
+	s.r().NoError(err)
+	s.Len(klines, 1)
+	s.r().Equal(&Kline{
+		OpenTime:                 1591256400000,
+		Open:                     "9653.29201333",
+		High:                     "9654.56401333",
+		Low:                      "9653.07367333",
+		Close:                    "9653.07367333",
+		Volume:                   "0",
+		CloseTime:                1591256459999,
+		QuoteAssetVolume:         "0",
+		TradeNum:                 60,
+		TakerBuyBaseAssetVolume:  "0",
+		TakerBuyQuoteAssetVolume: "0",
+	}, klines[0])
+}
@@ -0,0 +1,70 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// CountdownCancelAllService cancel all open orders of a symbol at the end of a countdown,
+// the countdown is reset by each request and a zero countdown disables it
+type CountdownCancelAllService struct {
+	c             *Client
+	symbol        string
+	countdownTime int64
+}
+
+// Symbol set symbol
+func (s *CountdownCancelAllService) Symbol(symbol string) *CountdownCancelAllService {
+	s.symbol = symbol
+	return s
+}
+
+// CountdownTime set countdownTime in milliseconds, 0 cancels the countdown
+func (s *CountdownCancelAllService) CountdownTime(countdownTime int64) *CountdownCancelAllService {
+	s.countdownTime = countdownTime
+	return s
+}
+
+// Do send request
+func (s *CountdownCancelAllService) Do(ctx context.Context, opts ...RequestOption) (res *CountdownCancelAll, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/dapi/v1/countdownCancelAll",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"symbol":        s.symbol,
+		"countdownTime": s.countdownTime,
+	})
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CountdownCancelAll)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountdownCancelAll define the countdown of a symbol
+type CountdownCancelAll struct {
+	Symbol        string `json:"symbol"`
+	CountdownTime string `json:"countdownTime"`
+}
+
+// NewCountdownHeartbeat init a heartbeat keeping the countdown cancel-all of symbol armed, call Start to set
+// the countdown and reset it in the background. The open orders of symbol are cancelled by the exchange
+// if no heartbeat is received for countdown.
+func (c *Client) NewCountdownHeartbeat(symbol string, countdown time.Duration) *common.CountdownHeartbeat {
+	return common.NewCountdownHeartbeat(func(ctx context.Context, countdown time.Duration) error {
+		_, err := c.NewCountdownCancelAllService().Symbol(symbol).
+			CountdownTime(countdown.Milliseconds()).Do(ctx)
+		return err
+	}, nil, countdown)
+}
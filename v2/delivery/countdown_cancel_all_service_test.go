@@ -0,0 +1,64 @@
+package delivery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type countdownCancelAllServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestCountdownCancelAllService(t *testing.T) {
+	suite.Run(t, new(countdownCancelAllServiceTestSuite))
+}
+
+func (s *countdownCancelAllServiceTestSuite) TestCountdownCancelAll() {
+	data := []byte(`{
+		"symbol": "BTCUSD_PERP",
+		"countdownTime": "100000"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_PERP"
+	countdownTime := int64(100000)
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":        symbol,
+			"countdownTime": countdownTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCountdownCancelAllService().Symbol(symbol).
+		CountdownTime(countdownTime).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&CountdownCancelAll{
+		Symbol:        symbol,
+		CountdownTime: "100000",
+	}, res)
+}
+
+func (s *countdownCancelAllServiceTestSuite) TestCountdownHeartbeat() {
+	data := []byte(`{
+		"symbol": "BTCUSD_PERP",
+		"countdownTime": "60000"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":        "BTCUSD_PERP",
+			"countdownTime": 60000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	h := s.client.NewCountdownHeartbeat("BTCUSD_PERP", time.Minute)
+	s.r().Zero(h.Interval)
+	s.r().NoError(h.Start(newContext()))
+	h.Stop()
+}
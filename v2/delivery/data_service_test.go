@@ -0,0 +1,268 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type dataServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestDataService(t *testing.T) {
+	suite.Run(t, new(dataServiceTestSuite))
+}
+
+func (s *dataServiceTestSuite) TestOpenInterestStatistics() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"contractType": "CURRENT_QUARTER",
+			"sumOpenInterest": "20403",
+			"sumOpenInterestValue": "176196512.23400000",
+			"timestamp": 1591261042378
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	contractType := ContractTypeCurrentQuarter
+	period := "5m"
+	limit := 10
+	startTime := int64(1591261000000)
+	endTime := int64(1591262000000)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":         pair,
+			"contractType": contractType,
+			"period":       period,
+			"limit":        limit,
+			"startTime":    startTime,
+			"endTime":      endTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewOpenInterestStatisticsService().Pair(pair).
+		ContractType(contractType).Period(period).Limit(limit).
+		StartTime(startTime).EndTime(endTime).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*OpenInterestStatistic{
+		{
+			Pair:                 pair,
+			ContractType:         contractType,
+			SumOpenInterest:      "20403",
+			SumOpenInterestValue: "176196512.23400000",
+			Timestamp:            1591261042378,
+		},
+	}, res)
+}
+
+func (s *dataServiceTestSuite) TestTopLongShortAccountRatio() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"longShortRatio": "1.8105",
+			"longAccount": "0.6442",
+			"shortAccount": "0.3558",
+			"timestamp": 1591261042378
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	period := "5m"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":   pair,
+			"period": period,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewTopLongShortAccountRatioService().Pair(pair).
+		Period(period).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*TopLongShortAccountRatio{
+		{
+			Pair:           pair,
+			LongShortRatio: "1.8105",
+			LongAccount:    "0.6442",
+			ShortAccount:   "0.3558",
+			Timestamp:      1591261042378,
+		},
+	}, res)
+}
+
+func (s *dataServiceTestSuite) TestTopLongShortPositionRatio() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"longShortRatio": "0.7869",
+			"longPosition": "0.4404",
+			"shortPosition": "0.5596",
+			"timestamp": 1592870400000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	period := "1h"
+	limit := 1
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":   pair,
+			"period": period,
+			"limit":  limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewTopLongShortPositionRatioService().Pair(pair).
+		Period(period).Limit(limit).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*TopLongShortPositionRatio{
+		{
+			Pair:           pair,
+			LongShortRatio: "0.7869",
+			LongPosition:   "0.4404",
+			ShortPosition:  "0.5596",
+			Timestamp:      1592870400000,
+		},
+	}, res)
+}
+
+func (s *dataServiceTestSuite) TestLongShortRatio() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"longShortRatio": "0.1960",
+			"longAccount": "0.6622",
+			"shortAccount": "0.3378",
+			"timestamp": 1583139600000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	period := "1d"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":   pair,
+			"period": period,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewLongShortRatioService().Pair(pair).
+		Period(period).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*LongShortRatio{
+		{
+			Pair:           pair,
+			LongShortRatio: "0.1960",
+			LongAccount:    "0.6622",
+			ShortAccount:   "0.3378",
+			Timestamp:      1583139600000,
+		},
+	}, res)
+}
+
+func (s *dataServiceTestSuite) TestTakerBuySellVolume() {
+	data := []byte(`[
+		{
+			"pair": "BTCUSD",
+			"contractType": "PERPETUAL",
+			"takerBuyVol": "387",
+			"takerSellVol": "248",
+			"takerBuyVolValue": "2.342",
+			"takerSellVolValue": "1.5",
+			"timestamp": 1591261042378
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	contractType := ContractTypePerpetual
+	period := "5m"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":         pair,
+			"contractType": contractType,
+			"period":       period,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewTakerBuySellVolumeService().Pair(pair).
+		ContractType(contractType).Period(period).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*TakerBuySellVolume{
+		{
+			Pair:                 pair,
+			ContractType:         contractType,
+			TakerBuyVolume:       "387",
+			TakerSellVolume:      "248",
+			TakerBuyVolumeValue:  "2.342",
+			TakerSellVolumeValue: "1.5",
+			Timestamp:            1591261042378,
+		},
+	}, res)
+}
+
+func (s *dataServiceTestSuite) TestBasis() {
+	data := []byte(`[
+		{
+			"indexPrice": "29269.93972727",
+			"contractType": "CURRENT_QUARTER",
+			"basisRate": "0.0024",
+			"futuresPrice": "29341.3",
+			"annualizedBasisRate": "0.0283",
+			"basis": "71.36027273",
+			"pair": "BTCUSD",
+			"timestamp": 1653381600000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	contractType := ContractTypeCurrentQuarter
+	period := "5m"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":         pair,
+			"contractType": contractType,
+			"period":       period,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewBasisService().Pair(pair).
+		ContractType(contractType).Period(period).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*Basis{
+		{
+			Pair:                pair,
+			ContractType:        contractType,
+			IndexPrice:          "29269.93972727",
+			FuturesPrice:        "29341.3",
+			Basis:               "71.36027273",
+			BasisRate:           "0.0024",
+			AnnualizedBasisRate: "0.0283",
+			Timestamp:           1653381600000,
+		},
+	}, res)
+}
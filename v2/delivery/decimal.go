@@ -0,0 +1,587 @@
+package delivery
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// AvgPriceDecimal return AvgPrice as a decimal, see common.ToDecimal
+func (o *Order) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.AvgPrice)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal, see common.ToDecimal
+func (o *Order) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.ExecutedQuantity)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal, see common.ToDecimal
+func (o *Order) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.OrigQuantity)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (o *Order) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.Price)
+}
+
+// StopPriceDecimal return StopPrice as a decimal, see common.ToDecimal
+func (o *Order) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.StopPrice)
+}
+
+// ActivatePriceDecimal return ActivatePrice as a decimal, see common.ToDecimal
+func (o *Order) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.ActivatePrice)
+}
+
+// PriceRateDecimal return PriceRate as a decimal, see common.ToDecimal
+func (o *Order) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.PriceRate)
+}
+
+// CumQuantityDecimal return CumQuantity as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) CumQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.CumQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.ExecutedQuantity)
+}
+
+// AvgPriceDecimal return AvgPrice as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.AvgPrice)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.OrigQuantity)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.Price)
+}
+
+// StopPriceDecimal return StopPrice as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.StopPrice)
+}
+
+// ActivatePriceDecimal return ActivatePrice as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.ActivatePrice)
+}
+
+// PriceRateDecimal return PriceRate as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.PriceRate)
+}
+
+// OpenDecimal return Open as a decimal, see common.ToDecimal
+func (k *Kline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.Open)
+}
+
+// HighDecimal return High as a decimal, see common.ToDecimal
+func (k *Kline) HighDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.High)
+}
+
+// LowDecimal return Low as a decimal, see common.ToDecimal
+func (k *Kline) LowDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.Low)
+}
+
+// CloseDecimal return Close as a decimal, see common.ToDecimal
+func (k *Kline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.Close)
+}
+
+// VolumeDecimal return Volume as a decimal, see common.ToDecimal
+func (k *Kline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.Volume)
+}
+
+// QuoteAssetVolumeDecimal return QuoteAssetVolume as a decimal, see common.ToDecimal
+func (k *Kline) QuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.QuoteAssetVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal return TakerBuyBaseAssetVolume as a decimal, see common.ToDecimal
+func (k *Kline) TakerBuyBaseAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal return TakerBuyQuoteAssetVolume as a decimal, see common.ToDecimal
+func (k *Kline) TakerBuyQuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.TakerBuyQuoteAssetVolume)
+}
+
+// OpenDecimal return Open as a decimal, see common.ToDecimal
+func (w *WsKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Open)
+}
+
+// CloseDecimal return Close as a decimal, see common.ToDecimal
+func (w *WsKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Close)
+}
+
+// HighDecimal return High as a decimal, see common.ToDecimal
+func (w *WsKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.High)
+}
+
+// LowDecimal return Low as a decimal, see common.ToDecimal
+func (w *WsKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Low)
+}
+
+// VolumeDecimal return Volume as a decimal, see common.ToDecimal
+func (w *WsKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Volume)
+}
+
+// QuoteVolumeDecimal return QuoteVolume as a decimal, see common.ToDecimal
+func (w *WsKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.QuoteVolume)
+}
+
+// ActiveBuyVolumeDecimal return ActiveBuyVolume as a decimal, see common.ToDecimal
+func (w *WsKline) ActiveBuyVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.ActiveBuyVolume)
+}
+
+// ActiveBuyQuoteVolumeDecimal return ActiveBuyQuoteVolume as a decimal, see common.ToDecimal
+func (w *WsKline) ActiveBuyQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.ActiveBuyQuoteVolume)
+}
+
+// BalanceDecimal return Balance as a decimal, see common.ToDecimal
+func (b *Balance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.Balance)
+}
+
+// CrossWalletBalanceDecimal return CrossWalletBalance as a decimal, see common.ToDecimal
+func (b *Balance) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.CrossWalletBalance)
+}
+
+// CrossUnPnlDecimal return CrossUnPnl as a decimal, see common.ToDecimal
+func (b *Balance) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.CrossUnPnl)
+}
+
+// AvailableBalanceDecimal return AvailableBalance as a decimal, see common.ToDecimal
+func (b *Balance) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.AvailableBalance)
+}
+
+// WalletBalanceDecimal return WalletBalance as a decimal, see common.ToDecimal
+func (a *AccountAsset) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.WalletBalance)
+}
+
+// UnrealizedProfitDecimal return UnrealizedProfit as a decimal, see common.ToDecimal
+func (a *AccountAsset) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.UnrealizedProfit)
+}
+
+// MarginBalanceDecimal return MarginBalance as a decimal, see common.ToDecimal
+func (a *AccountAsset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.MarginBalance)
+}
+
+// MaintMarginDecimal return MaintMargin as a decimal, see common.ToDecimal
+func (a *AccountAsset) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.MaintMargin)
+}
+
+// InitialMarginDecimal return InitialMargin as a decimal, see common.ToDecimal
+func (a *AccountAsset) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.InitialMargin)
+}
+
+// PositionInitialMarginDecimal return PositionInitialMargin as a decimal, see common.ToDecimal
+func (a *AccountAsset) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.PositionInitialMargin)
+}
+
+// OpenOrderInitialMarginDecimal return OpenOrderInitialMargin as a decimal, see common.ToDecimal
+func (a *AccountAsset) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.OpenOrderInitialMargin)
+}
+
+// MaxWithdrawAmountDecimal return MaxWithdrawAmount as a decimal, see common.ToDecimal
+func (a *AccountAsset) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.MaxWithdrawAmount)
+}
+
+// CrossWalletBalanceDecimal return CrossWalletBalance as a decimal, see common.ToDecimal
+func (a *AccountAsset) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.CrossWalletBalance)
+}
+
+// CrossUnPnlDecimal return CrossUnPnl as a decimal, see common.ToDecimal
+func (a *AccountAsset) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.CrossUnPnl)
+}
+
+// AvailableBalanceDecimal return AvailableBalance as a decimal, see common.ToDecimal
+func (a *AccountAsset) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.AvailableBalance)
+}
+
+// InitialMarginDecimal return InitialMargin as a decimal, see common.ToDecimal
+func (a *AccountPosition) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.InitialMargin)
+}
+
+// MaintMarginDecimal return MaintMargin as a decimal, see common.ToDecimal
+func (a *AccountPosition) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.MaintMargin)
+}
+
+// UnrealizedProfitDecimal return UnrealizedProfit as a decimal, see common.ToDecimal
+func (a *AccountPosition) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.UnrealizedProfit)
+}
+
+// PositionInitialMarginDecimal return PositionInitialMargin as a decimal, see common.ToDecimal
+func (a *AccountPosition) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.PositionInitialMargin)
+}
+
+// OpenOrderInitialMarginDecimal return OpenOrderInitialMargin as a decimal, see common.ToDecimal
+func (a *AccountPosition) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.OpenOrderInitialMargin)
+}
+
+// LeverageDecimal return Leverage as a decimal, see common.ToDecimal
+func (a *AccountPosition) LeverageDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Leverage)
+}
+
+// EntryPriceDecimal return EntryPrice as a decimal, see common.ToDecimal
+func (a *AccountPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.EntryPrice)
+}
+
+// MaxQtyDecimal return MaxQty as a decimal, see common.ToDecimal
+func (a *AccountPosition) MaxQtyDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.MaxQty)
+}
+
+// EntryPriceDecimal return EntryPrice as a decimal, see common.ToDecimal
+func (p *PositionRisk) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.EntryPrice)
+}
+
+// MarkPriceDecimal return MarkPrice as a decimal, see common.ToDecimal
+func (p *PositionRisk) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.MarkPrice)
+}
+
+// UnRealizedProfitDecimal return UnRealizedProfit as a decimal, see common.ToDecimal
+func (p *PositionRisk) UnRealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.UnRealizedProfit)
+}
+
+// LiquidationPriceDecimal return LiquidationPrice as a decimal, see common.ToDecimal
+func (p *PositionRisk) LiquidationPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.LiquidationPrice)
+}
+
+// LeverageDecimal return Leverage as a decimal, see common.ToDecimal
+func (p *PositionRisk) LeverageDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.Leverage)
+}
+
+// MaxQuantityDecimal return MaxQuantity as a decimal, see common.ToDecimal
+func (p *PositionRisk) MaxQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.MaxQuantity)
+}
+
+// IsolatedMarginDecimal return IsolatedMargin as a decimal, see common.ToDecimal
+func (p *PositionRisk) IsolatedMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.IsolatedMargin)
+}
+
+// FundingRateDecimal return FundingRate as a decimal, see common.ToDecimal
+func (f *FundingRate) FundingRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(f.FundingRate)
+}
+
+// MarkPriceDecimal return MarkPrice as a decimal, see common.ToDecimal
+func (f *FundingRate) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(f.MarkPrice)
+}
+
+// BidPriceDecimal return BidPrice as a decimal, see common.ToDecimal
+func (b *BookTicker) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.BidPrice)
+}
+
+// BidQuantityDecimal return BidQuantity as a decimal, see common.ToDecimal
+func (b *BookTicker) BidQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.BidQuantity)
+}
+
+// AskPriceDecimal return AskPrice as a decimal, see common.ToDecimal
+func (b *BookTicker) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.AskPrice)
+}
+
+// AskQuantityDecimal return AskQuantity as a decimal, see common.ToDecimal
+func (b *BookTicker) AskQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.AskQuantity)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (e *WsAggTradeEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal, see common.ToDecimal
+func (e *WsAggTradeEvent) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.Quantity)
+}
+
+// MarkPriceDecimal return MarkPrice as a decimal, see common.ToDecimal
+func (e *WsMarkPriceEvent) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.MarkPrice)
+}
+
+// EstimatedSettlePriceDecimal return EstimatedSettlePrice as a decimal, see common.ToDecimal
+func (e *WsMarkPriceEvent) EstimatedSettlePriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.EstimatedSettlePrice)
+}
+
+// FundingRateDecimal return FundingRate as a decimal, see common.ToDecimal
+func (e *WsMarkPriceEvent) FundingRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.FundingRate)
+}
+
+// BestBidPriceDecimal return BestBidPrice as a decimal, see common.ToDecimal
+func (e *WsBookTickerEvent) BestBidPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.BestBidPrice)
+}
+
+// BestBidQtyDecimal return BestBidQty as a decimal, see common.ToDecimal
+func (e *WsBookTickerEvent) BestBidQtyDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.BestBidQty)
+}
+
+// BestAskPriceDecimal return BestAskPrice as a decimal, see common.ToDecimal
+func (e *WsBookTickerEvent) BestAskPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.BestAskPrice)
+}
+
+// BestAskQtyDecimal return BestAskQty as a decimal, see common.ToDecimal
+func (e *WsBookTickerEvent) BestAskQtyDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.BestAskQty)
+}
+
+// OriginalQtyDecimal return OriginalQty as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) OriginalQtyDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.OriginalQty)
+}
+
+// OriginalPriceDecimal return OriginalPrice as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) OriginalPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.OriginalPrice)
+}
+
+// AveragePriceDecimal return AveragePrice as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.AveragePrice)
+}
+
+// StopPriceDecimal return StopPrice as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.StopPrice)
+}
+
+// LastFilledQtyDecimal return LastFilledQty as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.LastFilledQty)
+}
+
+// AccumulatedFilledQtyDecimal return AccumulatedFilledQty as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) AccumulatedFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.AccumulatedFilledQty)
+}
+
+// LastFilledPriceDecimal return LastFilledPrice as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) LastFilledPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.LastFilledPrice)
+}
+
+// CommissionDecimal return Commission as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Commission)
+}
+
+// RealizedPnLDecimal return RealizedPnL as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) RealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.RealizedPnL)
+}
+
+// BidsNotionalDecimal return BidsNotional as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) BidsNotionalDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.BidsNotional)
+}
+
+// AsksNotionalDecimal return AsksNotional as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) AsksNotionalDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.AsksNotional)
+}
+
+// ActivationPriceDecimal return ActivationPrice as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) ActivationPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.ActivationPrice)
+}
+
+// CallbackRateDecimal return CallbackRate as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) CallbackRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.CallbackRate)
+}
+
+// BalanceDecimal return Balance as a decimal, see common.ToDecimal
+func (w *WsBalance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Balance)
+}
+
+// CrossWalletBalanceDecimal return CrossWalletBalance as a decimal, see common.ToDecimal
+func (w *WsBalance) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.CrossWalletBalance)
+}
+
+// BalanceChangeDecimal return BalanceChange as a decimal, see common.ToDecimal
+func (w *WsBalance) BalanceChangeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.BalanceChange)
+}
+
+// AmountDecimal return Amount as a decimal, see common.ToDecimal
+func (w *WsPosition) AmountDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Amount)
+}
+
+// EntryPriceDecimal return EntryPrice as a decimal, see common.ToDecimal
+func (w *WsPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.EntryPrice)
+}
+
+// MarkPriceDecimal return MarkPrice as a decimal, see common.ToDecimal
+func (w *WsPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.MarkPrice)
+}
+
+// UnrealizedPnLDecimal return UnrealizedPnL as a decimal, see common.ToDecimal
+func (w *WsPosition) UnrealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.UnrealizedPnL)
+}
+
+// MaintenanceMarginRequiredDecimal return MaintenanceMarginRequired as a decimal, see common.ToDecimal
+func (w *WsPosition) MaintenanceMarginRequiredDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.MaintenanceMarginRequired)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (t *Trade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(t.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal, see common.ToDecimal
+func (t *Trade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(t.Quantity)
+}
+
+// BaseQuantityDecimal return BaseQuantity as a decimal, see common.ToDecimal
+func (t *Trade) BaseQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(t.BaseQuantity)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (a *AggTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal, see common.ToDecimal
+func (a *AggTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Quantity)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (a *AccountTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal, see common.ToDecimal
+func (a *AccountTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Quantity)
+}
+
+// BaseQuantityDecimal return BaseQuantity as a decimal, see common.ToDecimal
+func (a *AccountTrade) BaseQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.BaseQuantity)
+}
+
+// CommissionDecimal return Commission as a decimal, see common.ToDecimal
+func (a *AccountTrade) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Commission)
+}
+
+// RealizedPnlDecimal return RealizedPnl as a decimal, see common.ToDecimal
+func (a *AccountTrade) RealizedPnlDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.RealizedPnl)
+}
+
+// IncomeDecimal return Income as a decimal, see common.ToDecimal
+func (i *IncomeHistory) IncomeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(i.Income)
+}
+
+// MakerCommissionRateDecimal return MakerCommissionRate as a decimal, see common.ToDecimal
+func (c *CommissionRate) MakerCommissionRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.MakerCommissionRate)
+}
+
+// TakerCommissionRateDecimal return TakerCommissionRate as a decimal, see common.ToDecimal
+func (c *CommissionRate) TakerCommissionRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.TakerCommissionRate)
+}
+
+// MarkPriceDecimal return MarkPrice as a decimal, see common.ToDecimal
+func (p *PremiumIndex) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.MarkPrice)
+}
+
+// IndexPriceDecimal return IndexPrice as a decimal, see common.ToDecimal
+func (p *PremiumIndex) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.IndexPrice)
+}
+
+// EstimatedSettlePriceDecimal return EstimatedSettlePrice as a decimal, see common.ToDecimal
+func (p *PremiumIndex) EstimatedSettlePriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.EstimatedSettlePrice)
+}
+
+// LastFundingRateDecimal return LastFundingRate as a decimal, see common.ToDecimal
+func (p *PremiumIndex) LastFundingRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.LastFundingRate)
+}
+
+// InterestRateDecimal return InterestRate as a decimal, see common.ToDecimal
+func (p *PremiumIndex) InterestRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.InterestRate)
+}
+
+// OpenInterestDecimal return OpenInterest as a decimal, see common.ToDecimal
+func (o *OpenInterest) OpenInterestDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.OpenInterest)
+}
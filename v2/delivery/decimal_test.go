@@ -0,0 +1,34 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimalAccessors(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   func() (decimal.Decimal, error)
+		want    string
+		wantErr bool
+	}{
+		{name: "avg price", value: (&Order{AvgPrice: "0.10000001"}).AvgPriceDecimal, want: "0.10000001"},
+		{name: "empty activate price", value: (&Order{ActivatePrice: ""}).ActivatePriceDecimal, want: "0"},
+		{name: "malformed quantity", value: (&Order{OrigQuantity: "1.5x"}).OrigQuantityDecimal, wantErr: true},
+		{name: "kline close", value: (&Kline{Close: "9639.0"}).CloseDecimal, want: "9639"},
+		{name: "malformed kline open", value: (&Kline{Open: "NaN"}).OpenDecimal, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := tt.value()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, d.String())
+		})
+	}
+}
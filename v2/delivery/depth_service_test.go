@@ -0,0 +1,68 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type depthServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestDepthService(t *testing.T) {
+	suite.Run(t, new(depthServiceTestSuite))
+}
+
+func (s *depthServiceTestSuite) TestDepth() {
+	data := []byte(`{
+		"lastUpdateId": 16769853,
+		"symbol": "BTCUSD_PERP",
+		"pair": "BTCUSD",
+		"E": 1591250106370,
+		"T": 1591250106368,
+		"bids": [
+			[
+				"9638.0",
+				"431"
+			]
+		],
+		"asks": [
+			[
+				"9638.2",
+				"12"
+			]
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	symbol := "BTCUSD_PERP"
+	limit := 5
+	s.assertReq(func(r *request) {
+		e := newRequest().setParam("symbol", symbol).
+			setParam("limit", limit)
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewDepthService().Symbol(symbol).Limit(limit).Do(newContext())
+	s.r().NoError(err)
+	e := &DepthResponse{
+		LastUpdateID: 16769853,
+		Time:         1591250106370,
+		TradeTime:    1591250106368,
+		Symbol:       "BTCUSD_PERP",
+		Pair:         "BTCUSD",
+		Bids: []Bid{
+			{
+				Price:    "9638.0",
+				Quantity: "431",
+			},
+		},
+		Asks: []Ask{
+			{
+				Price:    "9638.2",
+				Quantity: "12",
+			},
+		},
+	}
+	s.r().Equal(e, res)
+}
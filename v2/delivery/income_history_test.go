@@ -0,0 +1,64 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type incomeHistoryServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestIncomeHistoryService(t *testing.T) {
+	suite.Run(t, new(incomeHistoryServiceTestSuite))
+}
+
+func (s *incomeHistoryServiceTestSuite) TestGetIncomeHistory() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSD_200925",
+			"incomeType": "COMMISSION",
+			"income": "-0.00000120",
+			"asset": "BTC",
+			"info": "",
+			"time": 1602734400000,
+			"tranId": 3912211,
+			"tradeId": "2"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_200925"
+	incomeType := "COMMISSION"
+	startTime := int64(1602734400000)
+	endTime := int64(1602734500000)
+	limit := int64(10)
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":     symbol,
+			"incomeType": incomeType,
+			"startTime":  startTime,
+			"endTime":    endTime,
+			"limit":      limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetIncomeHistoryService().Symbol(symbol).
+		IncomeType(incomeType).StartTime(startTime).EndTime(endTime).
+		Limit(limit).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal(&IncomeHistory{
+		Asset:      "BTC",
+		Income:     "-0.00000120",
+		IncomeType: incomeType,
+		Symbol:     symbol,
+		Time:       1602734400000,
+		TranID:     3912211,
+		TradeID:    "2",
+	}, res[0])
+}
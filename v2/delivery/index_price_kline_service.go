@@ -0,0 +1,70 @@
+package delivery
+
+import (
+	"context"
+	"net/http"
+)
+
+// IndexPriceKlinesService list the index price klines of a pair
+type IndexPriceKlinesService struct {
+	c         *Client
+	pair      string
+	interval  string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Pair set pair
+func (s *IndexPriceKlinesService) Pair(pair string) *IndexPriceKlinesService {
+	s.pair = pair
+	return s
+}
+
+// Interval set interval
+func (s *IndexPriceKlinesService) Interval(interval string) *IndexPriceKlinesService {
+	s.interval = interval
+	return s
+}
+
+// Limit set limit
+func (s *IndexPriceKlinesService) Limit(limit int) *IndexPriceKlinesService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *IndexPriceKlinesService) StartTime(startTime int64) *IndexPriceKlinesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *IndexPriceKlinesService) EndTime(endTime int64) *IndexPriceKlinesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request, the volumes and the number of trades are zero
+func (s *IndexPriceKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/indexPriceKlines",
+	}
+	r.setParam("pair", s.pair)
+	r.setParam("interval", s.interval)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	return parseKlines(data)
+}
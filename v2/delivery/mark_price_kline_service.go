@@ -0,0 +1,70 @@
+package delivery
+
+import (
+	"context"
+	"net/http"
+)
+
+// MarkPriceKlinesService list the mark price klines of a symbol
+type MarkPriceKlinesService struct {
+	c         *Client
+	symbol    string
+	interval  string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *MarkPriceKlinesService) Symbol(symbol string) *MarkPriceKlinesService {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *MarkPriceKlinesService) Interval(interval string) *MarkPriceKlinesService {
+	s.interval = interval
+	return s
+}
+
+// Limit set limit
+func (s *MarkPriceKlinesService) Limit(limit int) *MarkPriceKlinesService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *MarkPriceKlinesService) StartTime(startTime int64) *MarkPriceKlinesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *MarkPriceKlinesService) EndTime(endTime int64) *MarkPriceKlinesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request, the volumes and the number of trades are zero
+func (s *MarkPriceKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/markPriceKlines",
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("interval", s.interval)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	return parseKlines(data)
+}
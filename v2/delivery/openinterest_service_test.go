@@ -0,0 +1,44 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type openInterestServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestOpenInterestService(t *testing.T) {
+	suite.Run(t, new(openInterestServiceTestSuite))
+}
+
+func (s *openInterestServiceTestSuite) TestGetOpenInterest() {
+	data := []byte(`{
+		"symbol": "BTCUSD_200626",
+		"pair": "BTCUSD",
+		"openInterest": "15004",
+		"contractType": "CURRENT_QUARTER",
+		"time": 1591261042378
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_200626"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParam("symbol", symbol)
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetOpenInterestService().Symbol(symbol).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&OpenInterest{
+		Symbol:       symbol,
+		Pair:         "BTCUSD",
+		OpenInterest: "15004",
+		ContractType: ContractTypeCurrentQuarter,
+		Time:         1591261042378,
+	}, res)
+}
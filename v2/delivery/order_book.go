@@ -0,0 +1,99 @@
+package delivery
+
+import (
+	"context"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// OrderBook maintains a local order book of a symbol from a depth snapshot and the diff depth stream.
+// The book is resynced from a new snapshot when a gap is detected in the update sequence.
+type OrderBook struct {
+	*common.OrderBook
+	c      *Client
+	symbol string
+	limit  int
+	rate   time.Duration
+}
+
+// NewOrderBook init an order book of symbol, call Serve to start syncing it
+func (c *Client) NewOrderBook(symbol string) *OrderBook {
+	b := &OrderBook{
+		c:      c,
+		symbol: symbol,
+		limit:  1000,
+		rate:   250 * time.Millisecond,
+	}
+	b.OrderBook = common.NewOrderBook(common.OrderBookSequenceFutures, b.snapshot)
+	return b
+}
+
+// Limit set the depth of the snapshot, 1000 by default
+func (b *OrderBook) Limit(limit int) *OrderBook {
+	b.limit = limit
+	return b
+}
+
+// Rate set the update speed of the diff depth stream, 100ms, 250ms (default) or 500ms
+func (b *OrderBook) Rate(rate time.Duration) *OrderBook {
+	b.rate = rate
+	return b
+}
+
+// OnChange set the handler called after the book changed
+func (b *OrderBook) OnChange(handler func(book *OrderBook)) *OrderBook {
+	b.ChangeHandler = func(*common.OrderBook) {
+		handler(b)
+	}
+	return b
+}
+
+// OnError set the handler called for snapshot, gap and stream errors
+func (b *OrderBook) OnError(errHandler ErrHandler) *OrderBook {
+	b.ErrHandler = errHandler
+	return b
+}
+
+// Serve subscribes to the diff depth stream and syncs the book until stopC is closed,
+// the book is reset once the stream is done
+func (b *OrderBook) Serve() (doneC, stopC chan struct{}, err error) {
+	handler := func(event *WsDepthEvent) {
+		b.Update(&common.DepthUpdate{
+			FirstUpdateID:    event.FirstUpdateID,
+			LastUpdateID:     event.LastUpdateID,
+			PrevLastUpdateID: event.PrevLastUpdateID,
+			Bids:             event.Bids,
+			Asks:             event.Asks,
+		})
+	}
+	errHandler := func(err error) {
+		if b.ErrHandler != nil {
+			b.ErrHandler(err)
+		}
+	}
+	rate := b.rate
+	wsDoneC, stopC, err := WsDiffDepthServeWithRate(b.symbol, &rate, handler, errHandler)
+	if err != nil {
+		return nil, nil, err
+	}
+	doneC = make(chan struct{})
+	go func() {
+		<-wsDoneC
+		b.Reset()
+		close(doneC)
+	}()
+	return doneC, stopC, nil
+}
+
+func (b *OrderBook) snapshot(ctx context.Context) (*common.DepthSnapshot, error) {
+	res, err := b.c.NewDepthService().Symbol(b.symbol).Limit(b.limit).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &common.DepthSnapshot{
+		LastUpdateID: res.LastUpdateID,
+		Bids:         res.Bids,
+		Asks:         res.Asks,
+	}, nil
+}
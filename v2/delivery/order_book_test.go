@@ -0,0 +1,94 @@
+package delivery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type orderBookTestSuite struct {
+	baseTestSuite
+	origWsServe func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error)
+	wsHandler   WsHandler
+	endpoint    string
+}
+
+func TestOrderBook(t *testing.T) {
+	suite.Run(t, new(orderBookTestSuite))
+}
+
+func (s *orderBookTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.origWsServe = wsServe
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		s.endpoint = cfg.Endpoint
+		s.wsHandler = handler
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+}
+
+func (s *orderBookTestSuite) TearDownTest() {
+	wsServe = s.origWsServe
+}
+
+func (s *orderBookTestSuite) TestServe() {
+	s.mockDo([]byte(`{
+		"lastUpdateId": 102,
+		"E": 1591250106370,
+		"T": 1591250106368,
+		"bids": [["9638.0", "431"], ["9637.0", "10"]],
+		"asks": [["9638.2", "12"], ["9640.0", "1"]]
+	}`), nil)
+	s.assertReq(func(r *request) {
+		s.r().Equal("BTCUSD_PERP", r.query.Get("symbol"))
+		s.r().Equal("500", r.query.Get("limit"))
+	})
+
+	changes := make(chan int64, 10)
+	errC := make(chan error, 10)
+	book := s.client.NewOrderBook("BTCUSD_PERP").Limit(500).Rate(100 * time.Millisecond).OnChange(func(book *OrderBook) {
+		changes <- book.LastUpdateID()
+	}).OnError(func(err error) {
+		errC <- err
+	})
+	doneC, stopC, err := book.Serve()
+	s.r().NoError(err)
+	s.r().Contains(s.endpoint, "btcusd_perp@depth@100ms")
+
+	// the first update following the snapshot contains its lastUpdateId
+	s.wsHandler([]byte(`{"e":"depthUpdate","E":1,"T":1,"s":"BTCUSD_PERP","U":100,"u":105,"pu":99,"b":[["9638.0","0"]],"a":[]}`))
+	s.r().Equal(int64(105), <-changes)
+	s.assertDo()
+
+	bid, ok := book.BestBid()
+	s.r().True(ok)
+	s.r().Equal(common.PriceLevel{Price: "9637.0", Quantity: "10"}, bid)
+	ask, ok := book.BestAsk()
+	s.r().True(ok)
+	s.r().Equal(common.PriceLevel{Price: "9638.2", Quantity: "12"}, ask)
+
+	s.wsHandler([]byte(`{"e":"depthUpdate","E":2,"T":2,"s":"BTCUSD_PERP","U":110,"u":112,"pu":105,"b":[],"a":[["9639.0","2"]]}`))
+	s.r().Equal(int64(112), <-changes)
+	s.r().Equal([]Ask{
+		{Price: "9638.2", Quantity: "12"},
+		{Price: "9639.0", Quantity: "2"},
+	}, book.Asks(2))
+
+	// pu does not match the last update, the book is resynced
+	s.wsHandler([]byte(`{"e":"depthUpdate","E":3,"T":3,"s":"BTCUSD_PERP","U":120,"u":121,"pu":115,"b":[],"a":[]}`))
+	s.r().ErrorIs(<-errC, common.ErrorOrderBookGap)
+	s.r().False(book.IsSynced())
+
+	close(stopC)
+	<-doneC
+	s.r().False(book.IsSynced())
+}
@@ -129,12 +129,8 @@ func (s *CreateOrderService) ClosePosition(closePosition bool) *CreateOrderServi
 	return s
 }
 
-func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
-	r := &request{
-		method:   http.MethodPost,
-		endpoint: endpoint,
-		secType:  secTypeSigned,
-	}
+// orderParams return the parameters of the order, shared by the single and batch order requests
+func (s *CreateOrderService) orderParams() params {
 	m := params{
 		"symbol":           s.symbol,
 		"side":             s.side,
@@ -177,7 +173,16 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.closePosition != nil {
 		m["closePosition"] = *s.closePosition
 	}
-	r.setFormParams(m)
+	return m
+}
+
+func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(s.orderParams())
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return []byte{}, err
@@ -629,3 +634,474 @@ type LiquidationOrder struct {
 	Side             SideType        `json:"side"`
 	Time             int64           `json:"time"`
 }
+
+// ModifyOrderService modify a limit order
+type ModifyOrderService struct {
+	c                 *Client
+	orderID           *int64
+	origClientOrderID *string
+	symbol            string
+	side              SideType
+	quantity          *string
+	price             *string
+	priceMatch        *PriceMatchType
+}
+
+// Symbol set symbol
+func (s *ModifyOrderService) Symbol(symbol string) *ModifyOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID will prevail over OrigClientOrderID
+func (s *ModifyOrderService) OrderID(orderID int64) *ModifyOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID is not necessary if OrderID is provided
+func (s *ModifyOrderService) OrigClientOrderID(origClientOrderID string) *ModifyOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Side set side
+func (s *ModifyOrderService) Side(side SideType) *ModifyOrderService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *ModifyOrderService) Quantity(quantity string) *ModifyOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// Price set price
+func (s *ModifyOrderService) Price(price string) *ModifyOrderService {
+	s.price = &price
+	return s
+}
+
+// PriceMatch set priceMatch
+func (s *ModifyOrderService) PriceMatch(priceMatch PriceMatchType) *ModifyOrderService {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+// Do send request:
+//   - Either orderId or origClientOrderId must be sent, and the orderId will prevail if both are sent
+//   - Either price or priceMatch must be sent. Sending both will fail the request
+//   - The order will be cancelled by the amendment if it is partially filled and the new quantity <= executedQty,
+//     or if it is TimeInForceTypeGTX and the new price would cause it to be executed immediately
+//   - One order can only be modified for less than 10000 times
+func (s *ModifyOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/dapi/v1/order",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(modifyOrderParams(s.symbol, s.side, s.orderID, s.origClientOrderID, s.quantity, s.price, s.priceMatch))
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// modifyOrderParams return the parameters of an order modification, shared by the single and batch requests
+func modifyOrderParams(symbol string, side SideType, orderID *int64, origClientOrderID, quantity, price *string, priceMatch *PriceMatchType) params {
+	m := params{
+		"symbol": symbol,
+		"side":   side,
+	}
+	if orderID != nil {
+		// the batch request expects a string, a number is rejected with code -1102
+		m["orderId"] = strconv.FormatInt(*orderID, 10)
+	}
+	if origClientOrderID != nil {
+		m["origClientOrderId"] = *origClientOrderID
+	}
+	if quantity != nil {
+		m["quantity"] = *quantity
+	}
+	if price != nil {
+		m["price"] = *price
+	}
+	if priceMatch != nil {
+		m["priceMatch"] = *priceMatch
+	}
+	return m
+}
+
+// CreateBatchOrdersService place up to 5 orders in one request
+type CreateBatchOrdersService struct {
+	c      *Client
+	orders []*CreateOrderService
+}
+
+// CreateBatchOrdersResponse contains the response from CreateBatchOrders operation
+type CreateBatchOrdersResponse struct {
+	// Total number of messages in the response
+	N int
+	// List of orders which were placed successfully which can have a length between 0 and N
+	Orders []*Order
+	// List of errors of length N, where each item corresponds to a nil value if
+	// the order from that specific index was placed successfully OR an non-nil *APIError if there was an error with
+	// the order at that index
+	Errors []error
+}
+
+// OrderList set the orders, created with Client.NewCreateOrderService
+func (s *CreateBatchOrdersService) OrderList(orders []*CreateOrderService) *CreateBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request
+func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CreateBatchOrdersResponse, err error) {
+	orders := make([]params, 0, len(s.orders))
+	for _, order := range s.orders {
+		orders = append(orders, order.orderParams())
+	}
+	placed, errs, err := s.c.batchOrders(ctx, http.MethodPost, orders, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateBatchOrdersResponse{N: len(errs), Orders: placed, Errors: errs}, nil
+}
+
+// ModifyOrder contains parameters for order modification request
+type ModifyOrder struct {
+	orderID           *int64
+	origClientOrderID *string
+	symbol            string
+	side              SideType
+	quantity          *string
+	price             *string
+	priceMatch        *PriceMatchType
+}
+
+// Symbol set symbol
+func (s *ModifyOrder) Symbol(symbol string) *ModifyOrder {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID will prevail over OrigClientOrderID
+func (s *ModifyOrder) OrderID(orderID int64) *ModifyOrder {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID is not necessary if OrderID is provided
+func (s *ModifyOrder) OrigClientOrderID(origClientOrderID string) *ModifyOrder {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Side set side
+func (s *ModifyOrder) Side(side SideType) *ModifyOrder {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *ModifyOrder) Quantity(quantity string) *ModifyOrder {
+	s.quantity = &quantity
+	return s
+}
+
+// Price set price
+func (s *ModifyOrder) Price(price string) *ModifyOrder {
+	s.price = &price
+	return s
+}
+
+// PriceMatch set priceMatch
+func (s *ModifyOrder) PriceMatch(priceMatch PriceMatchType) *ModifyOrder {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+// ModifyBatchOrdersService modify up to 5 orders in one request
+type ModifyBatchOrdersService struct {
+	c      *Client
+	orders []*ModifyOrder
+}
+
+// ModifyBatchOrdersResponse contains the response from ModifyBatchOrders operation
+type ModifyBatchOrdersResponse struct {
+	// Total number of messages in the response
+	N int
+	// List of orders which were modified successfully which can have a length between 0 and N
+	Orders []*Order
+	// List of errors of length N, where each item corresponds to a nil value if
+	// the order from that specific index was modified successfully OR an non-nil *APIError if there was an error with
+	// the order at that index
+	Errors []error
+}
+
+// OrderList set the orders to modify
+func (s *ModifyBatchOrdersService) OrderList(orders []*ModifyOrder) *ModifyBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// Do send request
+func (s *ModifyBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *ModifyBatchOrdersResponse, err error) {
+	orders := make([]params, 0, len(s.orders))
+	for _, order := range s.orders {
+		orders = append(orders, modifyOrderParams(order.symbol, order.side, order.orderID, order.origClientOrderID, order.quantity, order.price, order.priceMatch))
+	}
+	modified, errs, err := s.c.batchOrders(ctx, http.MethodPut, orders, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ModifyBatchOrdersResponse{N: len(errs), Orders: modified, Errors: errs}, nil
+}
+
+// batchOrders sends a batch of orders, the errors are returned by index of the order
+func (c *Client) batchOrders(ctx context.Context, method string, orders []params, opts ...RequestOption) (res []*Order, errs []error, err error) {
+	b, err := json.Marshal(orders)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := &request{
+		method:   method,
+		endpoint: "/dapi/v1/batchOrders",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("batchOrders", string(b))
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	rawMessages := make([]json.RawMessage, 0)
+	err = json.Unmarshal(data, &rawMessages)
+	if err != nil {
+		return nil, nil, err
+	}
+	errs = make([]error, len(rawMessages))
+	for i, raw := range rawMessages {
+		// check if response is an API error
+		e := new(common.APIError)
+		if err = json.Unmarshal(raw, e); err != nil {
+			return nil, nil, err
+		}
+		if e.IsValid() {
+			errs[i] = e
+			continue
+		}
+		o := new(Order)
+		if err = json.Unmarshal(raw, o); err != nil {
+			return nil, nil, err
+		}
+		res = append(res, o)
+	}
+	return res, errs, nil
+}
+
+// CancelMultiplesOrdersService cancel a list of orders
+type CancelMultiplesOrdersService struct {
+	c                     *Client
+	symbol                string
+	orderIDList           []int64
+	origClientOrderIDList []string
+}
+
+// Symbol set symbol
+func (s *CancelMultiplesOrdersService) Symbol(symbol string) *CancelMultiplesOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderIDList set orderIdList, up to 10 orders
+func (s *CancelMultiplesOrdersService) OrderIDList(orderIDList []int64) *CancelMultiplesOrdersService {
+	s.orderIDList = orderIDList
+	return s
+}
+
+// OrigClientOrderIDList set origClientOrderIdList, up to 10 orders
+func (s *CancelMultiplesOrdersService) OrigClientOrderIDList(origClientOrderIDList []string) *CancelMultiplesOrdersService {
+	s.origClientOrderIDList = origClientOrderIDList
+	return s
+}
+
+// CancelMultipleOrdersResponse contains the response from CancelMultiplesOrders operation
+type CancelMultipleOrdersResponse struct {
+	// Total number of messages in the response
+	N int
+	// List of orders which were canceled successfully which can have a length between 0 and N
+	Orders []*CancelOrderResponse
+	// List of errors of length N, where each item corresponds to a nil value if
+	// the order from that specific index was canceled successfully OR an non-nil *APIError if there was an error with
+	// the order at that index
+	Errors []error
+}
+
+// Do send request
+func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CancelMultipleOrdersResponse, err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: "/dapi/v1/batchOrders",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("symbol", s.symbol)
+	if s.orderIDList != nil {
+		b, err := json.Marshal(s.orderIDList)
+		if err != nil {
+			return nil, err
+		}
+		r.setFormParam("orderIdList", string(b))
+	}
+	if s.origClientOrderIDList != nil {
+		b, err := json.Marshal(s.origClientOrderIDList)
+		if err != nil {
+			return nil, err
+		}
+		r.setFormParam("origClientOrderIdList", string(b))
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rawMessages := make([]json.RawMessage, 0)
+	err = json.Unmarshal(data, &rawMessages)
+	if err != nil {
+		return nil, err
+	}
+	res = &CancelMultipleOrdersResponse{N: len(rawMessages), Errors: make([]error, len(rawMessages))}
+	for i, raw := range rawMessages {
+		// check if response is an API error
+		e := new(common.APIError)
+		if err = json.Unmarshal(raw, e); err != nil {
+			return nil, err
+		}
+		if e.IsValid() {
+			res.Errors[i] = e
+			continue
+		}
+		o := new(CancelOrderResponse)
+		if err = json.Unmarshal(raw, o); err != nil {
+			return nil, err
+		}
+		res.Orders = append(res.Orders, o)
+	}
+	return res, nil
+}
+
+// ListUserLiquidationOrdersService lists user's liquidation orders
+type ListUserLiquidationOrdersService struct {
+	c             *Client
+	symbol        *string
+	pair          *string
+	autoCloseType *ForceOrderCloseType
+	startTime     *int64
+	endTime       *int64
+	limit         *int
+}
+
+// Symbol set symbol
+func (s *ListUserLiquidationOrdersService) Symbol(symbol string) *ListUserLiquidationOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// Pair set pair
+func (s *ListUserLiquidationOrdersService) Pair(pair string) *ListUserLiquidationOrdersService {
+	s.pair = &pair
+	return s
+}
+
+// AutoCloseType set autoCloseType, LIQUIDATION or ADL
+func (s *ListUserLiquidationOrdersService) AutoCloseType(autoCloseType ForceOrderCloseType) *ListUserLiquidationOrdersService {
+	s.autoCloseType = &autoCloseType
+	return s
+}
+
+// StartTime set startTime
+func (s *ListUserLiquidationOrdersService) StartTime(startTime int64) *ListUserLiquidationOrdersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListUserLiquidationOrdersService) EndTime(endTime int64) *ListUserLiquidationOrdersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *ListUserLiquidationOrdersService) Limit(limit int) *ListUserLiquidationOrdersService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListUserLiquidationOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*UserLiquidationOrder, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/forceOrders",
+		secType:  secTypeSigned,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	if s.pair != nil {
+		r.setParam("pair", *s.pair)
+	}
+	if s.autoCloseType != nil {
+		r.setParam("autoCloseType", *s.autoCloseType)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*UserLiquidationOrder{}, err
+	}
+	res = make([]*UserLiquidationOrder, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*UserLiquidationOrder{}, err
+	}
+	return res, nil
+}
+
+// UserLiquidationOrder defines user's liquidation order
+type UserLiquidationOrder struct {
+	OrderID          int64            `json:"orderId"`
+	Symbol           string           `json:"symbol"`
+	Pair             string           `json:"pair"`
+	Status           OrderStatusType  `json:"status"`
+	ClientOrderID    string           `json:"clientOrderId"`
+	Price            string           `json:"price"`
+	AvgPrice         string           `json:"avgPrice"`
+	OrigQuantity     string           `json:"origQty"`
+	ExecutedQuantity string           `json:"executedQty"`
+	CumBase          string           `json:"cumBase"`
+	TimeInForce      TimeInForceType  `json:"timeInForce"`
+	Type             OrderType        `json:"type"`
+	ReduceOnly       bool             `json:"reduceOnly"`
+	ClosePosition    bool             `json:"closePosition"`
+	Side             SideType         `json:"side"`
+	PositionSide     PositionSideType `json:"positionSide"`
+	StopPrice        string           `json:"stopPrice"`
+	WorkingType      WorkingType      `json:"workingType"`
+	PriceProtect     bool             `json:"priceProtect"`
+	OrigType         OrderType        `json:"origType"`
+	Time             int64            `json:"time"`
+	UpdateTime       int64            `json:"updateTime"`
+}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 type baseOrderTestSuite struct {
@@ -599,3 +601,316 @@ func (s *orderServiceTestSuite) assertLiquidationEqual(e, a *LiquidationOrder) {
 	r.Equal(e.Side, a.Side, "Side")
 	r.Equal(e.Time, a.Time, "Time")
 }
+
+func (s *orderServiceTestSuite) TestModifyOrder() {
+	data := []byte(`{
+		"orderId": 20072994037,
+		"symbol": "BTCUSD_PERP",
+		"pair": "BTCUSD",
+		"status": "NEW",
+		"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+		"price": "30005",
+		"avgPrice": "0.0",
+		"origQty": "1",
+		"executedQty": "0",
+		"cumQty": "0",
+		"cumBase": "0",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"reduceOnly": false,
+		"closePosition": false,
+		"side": "BUY",
+		"positionSide": "LONG",
+		"stopPrice": "0",
+		"workingType": "CONTRACT_PRICE",
+		"priceProtect": false,
+		"origType": "LIMIT",
+		"updateTime": 1629182711600
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_PERP"
+	orderID := int64(20072994037)
+	side := SideTypeBuy
+	quantity := "1"
+	price := "30005"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":   symbol,
+			"orderId":  orderID,
+			"side":     side,
+			"quantity": quantity,
+			"price":    price,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	order, err := s.client.NewModifyOrderService().Symbol(symbol).OrderID(orderID).
+		Side(side).Quantity(quantity).Price(price).Do(newContext())
+	s.r().NoError(err)
+	s.assertOrderEqual(&Order{
+		OrderID:          orderID,
+		Symbol:           symbol,
+		Pair:             "BTCUSD",
+		Status:           OrderStatusTypeNew,
+		ClientOrderID:    "LJ9R4QZDihCaS8UAOOLpgW",
+		Price:            price,
+		AvgPrice:         "0.0",
+		OrigQuantity:     quantity,
+		ExecutedQuantity: "0",
+		CumBase:          "0",
+		TimeInForce:      TimeInForceTypeGTC,
+		Type:             OrderTypeLimit,
+		Side:             side,
+		PositionSide:     PositionSideTypeLong,
+		StopPrice:        "0",
+		WorkingType:      WorkingTypeContractPrice,
+		OrigType:         OrderTypeLimit,
+		UpdateTime:       1629182711600,
+	}, order)
+}
+
+func (s *orderServiceTestSuite) TestCreateBatchOrders() {
+	data := []byte(`[
+		{
+			"clientOrderId": "testOrder",
+			"cumQty": "0",
+			"cumBase": "0",
+			"executedQty": "0",
+			"orderId": 22542179,
+			"avgPrice": "0.0",
+			"origQty": "10",
+			"price": "9000",
+			"side": "BUY",
+			"positionSide": "BOTH",
+			"status": "NEW",
+			"symbol": "BTCUSD_PERP",
+			"pair": "BTCUSD",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"origType": "LIMIT",
+			"updateTime": 1566818724722,
+			"workingType": "CONTRACT_PRICE"
+		},
+		{
+			"code": -2022,
+			"msg": "ReduceOnly Order is rejected."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"batchOrders": `[{"newClientOrderId":"testOrder","newOrderRespType":"ACK","price":"9000","quantity":"10","side":"BUY","symbol":"BTCUSD_PERP","timeInForce":"GTC","type":"LIMIT"},` +
+				`{"newClientOrderId":"testOrder2","newOrderRespType":"ACK","quantity":"1","reduceOnly":"true","side":"SELL","symbol":"BTCUSD_PERP","type":"MARKET"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateBatchOrdersService().OrderList([]*CreateOrderService{
+		s.client.NewCreateOrderService().Symbol("BTCUSD_PERP").Side(SideTypeBuy).
+			Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("10").Price("9000").
+			NewClientOrderID("testOrder").NewOrderResponseType(NewOrderRespTypeACK),
+		s.client.NewCreateOrderService().Symbol("BTCUSD_PERP").Side(SideTypeSell).
+			Type(OrderTypeMarket).Quantity("1").ReduceOnly(true).
+			NewClientOrderID("testOrder2").NewOrderResponseType(NewOrderRespTypeACK),
+	}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(2, res.N)
+	r.Len(res.Orders, 1)
+	r.Len(res.Errors, 2)
+	r.NoError(res.Errors[0])
+	r.Equal(int64(22542179), res.Orders[0].OrderID)
+	r.Equal("testOrder", res.Orders[0].ClientOrderID)
+	apiErr, ok := res.Errors[1].(*common.APIError)
+	r.True(ok)
+	r.Equal(int64(-2022), apiErr.Code)
+}
+
+func (s *orderServiceTestSuite) TestModifyBatchOrders() {
+	data := []byte(`[
+		{
+			"orderId": 20072994037,
+			"symbol": "BTCUSD_PERP",
+			"pair": "BTCUSD",
+			"status": "NEW",
+			"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+			"price": "30005",
+			"origQty": "1",
+			"side": "BUY",
+			"type": "LIMIT",
+			"updateTime": 1629182711600
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"batchOrders": `[{"orderId":"20072994037","price":"30005","quantity":"1","side":"BUY","symbol":"BTCUSD_PERP"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewModifyBatchOrdersService().OrderList([]*ModifyOrder{
+		new(ModifyOrder).Symbol("BTCUSD_PERP").OrderID(20072994037).Side(SideTypeBuy).
+			Quantity("1").Price("30005"),
+	}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(1, res.N)
+	r.Len(res.Orders, 1)
+	r.NoError(res.Errors[0])
+	r.Equal("30005", res.Orders[0].Price)
+}
+
+func (s *orderServiceTestSuite) TestCancelMultipleOrders() {
+	data := []byte(`[
+		{
+			"avgPrice": "0.0",
+			"clientOrderId": "myOrder1",
+			"cumQty": "0",
+			"cumBase": "0",
+			"executedQty": "0",
+			"orderId": 283194212,
+			"origQty": "11",
+			"origType": "TRAILING_STOP_MARKET",
+			"price": "0",
+			"reduceOnly": false,
+			"side": "BUY",
+			"positionSide": "SHORT",
+			"status": "CANCELED",
+			"stopPrice": "9300",
+			"closePosition": false,
+			"symbol": "BTCUSD_200925",
+			"pair": "BTCUSD",
+			"timeInForce": "GTC",
+			"type": "TRAILING_STOP_MARKET",
+			"activatePrice": "9020",
+			"priceRate": "0.3",
+			"workingType": "CONTRACT_PRICE",
+			"priceProtect": false,
+			"updateTime": 1571110484038
+		},
+		{
+			"code": -2011,
+			"msg": "Unknown order sent."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_200925"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":      symbol,
+			"orderIdList": "[283194212,283194213]",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCancelMultiplesOrdersService().Symbol(symbol).
+		OrderIDList([]int64{283194212, 283194213}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(2, res.N)
+	r.Len(res.Orders, 1)
+	r.NoError(res.Errors[0])
+	r.Error(res.Errors[1])
+	s.assertCancelOrderResponseEqual(&CancelOrderResponse{
+		AvgPrice:         "0.0",
+		ClientOrderID:    "myOrder1",
+		CumQuantity:      "0",
+		CumBase:          "0",
+		ExecutedQuantity: "0",
+		OrderID:          283194212,
+		OrigQuantity:     "11",
+		OrigType:         OrderTypeTrailingStopMarket,
+		Price:            "0",
+		Side:             SideTypeBuy,
+		PositionSide:     PositionSideTypeShort,
+		Status:           OrderStatusTypeCanceled,
+		StopPrice:        "9300",
+		Symbol:           symbol,
+		Pair:             "BTCUSD",
+		TimeInForce:      TimeInForceTypeGTC,
+		Type:             OrderTypeTrailingStopMarket,
+		ActivatePrice:    "9020",
+		PriceRate:        "0.3",
+		WorkingType:      WorkingTypeContractPrice,
+		UpdateTime:       1571110484038,
+	}, res.Orders[0])
+}
+
+func (s *orderServiceTestSuite) TestListUserLiquidationOrders() {
+	data := []byte(`[
+		{
+			"orderId": 165123080,
+			"symbol": "BTCUSD_200925",
+			"pair": "BTCUSD",
+			"status": "FILLED",
+			"clientOrderId": "autoclose-1596542005017000006",
+			"price": "11326.9",
+			"avgPrice": "11326.9",
+			"origQty": "1",
+			"executedQty": "1",
+			"cumBase": "0.00882854",
+			"timeInForce": "IOC",
+			"type": "LIMIT",
+			"reduceOnly": false,
+			"closePosition": false,
+			"side": "SELL",
+			"positionSide": "BOTH",
+			"stopPrice": "0",
+			"workingType": "CONTRACT_PRICE",
+			"priceProtect": false,
+			"origType": "LIMIT",
+			"time": 1596542005019,
+			"updateTime": 1596542005050
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_200925"
+	autoCloseType := ForceOrderCloseTypeLiquidation
+	startTime := int64(1596542005000)
+	endTime := int64(1596542006000)
+	limit := 10
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":        symbol,
+			"autoCloseType": autoCloseType,
+			"startTime":     startTime,
+			"endTime":       endTime,
+			"limit":         limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewListUserLiquidationOrdersService().Symbol(symbol).
+		AutoCloseType(autoCloseType).StartTime(startTime).EndTime(endTime).
+		Limit(limit).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal(&UserLiquidationOrder{
+		OrderID:          165123080,
+		Symbol:           symbol,
+		Pair:             "BTCUSD",
+		Status:           OrderStatusTypeFilled,
+		ClientOrderID:    "autoclose-1596542005017000006",
+		Price:            "11326.9",
+		AvgPrice:         "11326.9",
+		OrigQuantity:     "1",
+		ExecutedQuantity: "1",
+		CumBase:          "0.00882854",
+		TimeInForce:      TimeInForceTypeIOC,
+		Type:             OrderTypeLimit,
+		Side:             SideTypeSell,
+		PositionSide:     PositionSideTypeBoth,
+		StopPrice:        "0",
+		WorkingType:      WorkingTypeContractPrice,
+		OrigType:         OrderTypeLimit,
+		Time:             1596542005019,
+		UpdateTime:       1596542005050,
+	}, res[0])
+}
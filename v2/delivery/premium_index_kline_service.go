@@ -0,0 +1,70 @@
+package delivery
+
+import (
+	"context"
+	"net/http"
+)
+
+// PremiumIndexKlinesService list the premium index klines of a symbol
+type PremiumIndexKlinesService struct {
+	c         *Client
+	symbol    string
+	interval  string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Symbol set symbol
+func (s *PremiumIndexKlinesService) Symbol(symbol string) *PremiumIndexKlinesService {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *PremiumIndexKlinesService) Interval(interval string) *PremiumIndexKlinesService {
+	s.interval = interval
+	return s
+}
+
+// Limit set limit
+func (s *PremiumIndexKlinesService) Limit(limit int) *PremiumIndexKlinesService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *PremiumIndexKlinesService) StartTime(startTime int64) *PremiumIndexKlinesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *PremiumIndexKlinesService) EndTime(endTime int64) *PremiumIndexKlinesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request, the volumes and the number of trades are zero
+func (s *PremiumIndexKlinesService) Do(ctx context.Context, opts ...RequestOption) (res []*Kline, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/premiumIndexKlines",
+	}
+	r.setParam("symbol", s.symbol)
+	r.setParam("interval", s.interval)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Kline{}, err
+	}
+	return parseKlines(data)
+}
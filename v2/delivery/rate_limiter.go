@@ -0,0 +1,141 @@
+package delivery
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// NewRateLimiter init a client side rate limiter seeded with the rate limits of ExchangeInfo.
+// Assign it to Client.RateLimiter to enable it.
+func NewRateLimiter(rateLimits []RateLimit) *common.RateLimiter {
+	limits := make([]common.RateLimit, 0, len(rateLimits))
+	for _, rl := range rateLimits {
+		limits = append(limits, common.RateLimit(rl))
+	}
+	return common.NewRateLimiter(limits...)
+}
+
+// requestWeights define the REQUEST_WEIGHT of the /dapi endpoints, the default weight is 1
+var requestWeights = map[string]int64{
+	http.MethodGet + " /dapi/v1/allOrders":           20,
+	http.MethodGet + " /dapi/v1/account":             5,
+	http.MethodGet + " /dapi/v1/balance":             1,
+	http.MethodGet + " /dapi/v1/positionRisk":        1,
+	http.MethodGet + " /dapi/v1/allForceOrders":      20,
+	http.MethodGet + " /dapi/v1/fundingInfo":         0,
+	http.MethodPost + " /dapi/v1/order":              0,
+	http.MethodPut + " /dapi/v1/order":               1,
+	http.MethodPost + " /dapi/v1/batchOrders":        5,
+	http.MethodPut + " /dapi/v1/batchOrders":         5,
+	http.MethodDelete + " /dapi/v1/batchOrders":      1,
+	http.MethodGet + " /dapi/v1/income":              20,
+	http.MethodGet + " /dapi/v1/aggTrades":           20,
+	http.MethodGet + " /dapi/v1/historicalTrades":    20,
+	http.MethodGet + " /dapi/v1/commissionRate":      20,
+	http.MethodGet + " /dapi/v2/leverageBracket":     1,
+	http.MethodGet + " /dapi/v1/adlQuantile":         5,
+	http.MethodGet + " /dapi/v1/premiumIndex":        10,
+	http.MethodGet + " /dapi/v1/openInterest":        1,
+	http.MethodPost + " /dapi/v1/countdownCancelAll": 10,
+}
+
+// orderEndpoints define the endpoints counting against the ORDERS rate limits
+var orderEndpoints = map[string]int64{
+	http.MethodPost + " /dapi/v1/order": 1,
+	http.MethodPut + " /dapi/v1/order":  1,
+}
+
+// requestCost return the REQUEST_WEIGHT and ORDERS cost of the request
+func requestCost(r *request) (weight, orders int64) {
+	if !strings.HasPrefix(r.endpoint, "/dapi/") {
+		return 0, 0
+	}
+	key := r.method + " " + r.endpoint
+	orders = orderEndpoints[key]
+	if key == http.MethodPost+" /dapi/v1/batchOrders" || key == http.MethodPut+" /dapi/v1/batchOrders" {
+		orders = batchOrdersCount(r.form.Get("batchOrders"))
+	}
+	hasSymbol := r.query.Get("symbol") != "" || r.query.Get("pair") != ""
+	switch key {
+	case http.MethodGet + " /dapi/v1/depth":
+		return depthWeight(r.query.Get("limit")), orders
+	case http.MethodGet + " /dapi/v1/klines", http.MethodGet + " /dapi/v1/continuousKlines",
+		http.MethodGet + " /dapi/v1/indexPriceKlines", http.MethodGet + " /dapi/v1/markPriceKlines",
+		http.MethodGet + " /dapi/v1/premiumIndexKlines":
+		return klinesWeight(r.query.Get("limit")), orders
+	case http.MethodGet + " /dapi/v1/ticker/24hr":
+		if hasSymbol {
+			return 1, orders
+		}
+		return 40, orders
+	case http.MethodGet + " /dapi/v1/ticker/price", http.MethodGet + " /dapi/v1/ticker/bookTicker":
+		if hasSymbol {
+			return 1, orders
+		}
+		return 2, orders
+	case http.MethodGet + " /dapi/v1/openOrders":
+		if hasSymbol {
+			return 1, orders
+		}
+		return 40, orders
+	case http.MethodGet + " /dapi/v1/userTrades":
+		if r.query.Get("pair") != "" {
+			return 40, orders
+		}
+		return 20, orders
+	case http.MethodGet + " /dapi/v1/forceOrders":
+		if hasSymbol {
+			return 20, orders
+		}
+		return 50, orders
+	}
+	if w, ok := requestWeights[key]; ok {
+		return w, orders
+	}
+	return 1, orders
+}
+
+// batchOrdersCount return the number of orders in the batchOrders form param
+func batchOrdersCount(batchOrders string) int64 {
+	if batchOrders == "" {
+		return 1
+	}
+	return int64(strings.Count(batchOrders, "{"))
+}
+
+func depthWeight(limit string) int64 {
+	l, err := strconv.Atoi(limit)
+	if err != nil || l <= 0 {
+		l = 500
+	}
+	switch {
+	case l <= 50:
+		return 2
+	case l <= 100:
+		return 5
+	case l <= 500:
+		return 10
+	default:
+		return 20
+	}
+}
+
+func klinesWeight(limit string) int64 {
+	l, err := strconv.Atoi(limit)
+	if err != nil || l <= 0 {
+		l = 500
+	}
+	switch {
+	case l < 100:
+		return 1
+	case l < 500:
+		return 2
+	case l <= 1000:
+		return 5
+	default:
+		return 10
+	}
+}
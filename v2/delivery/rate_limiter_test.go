@@ -0,0 +1,37 @@
+package delivery
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type rateLimiterTestSuite struct {
+	baseTestSuite
+}
+
+func TestRateLimiter(t *testing.T) {
+	suite.Run(t, new(rateLimiterTestSuite))
+}
+
+func (s *rateLimiterTestSuite) TestRequestCost() {
+	tests := []struct {
+		r      *request
+		weight int64
+		orders int64
+	}{
+		{r: &request{method: http.MethodGet, endpoint: "/dapi/v1/depth", query: map[string][]string{"limit": {"100"}}}, weight: 5},
+		{r: &request{method: http.MethodGet, endpoint: "/dapi/v1/markPriceKlines", query: map[string][]string{"limit": {"1500"}}}, weight: 10},
+		{r: &request{method: http.MethodGet, endpoint: "/dapi/v1/userTrades", query: map[string][]string{"pair": {"BTCUSD"}}}, weight: 40},
+		{r: &request{method: http.MethodGet, endpoint: "/dapi/v1/forceOrders"}, weight: 50},
+		{r: &request{method: http.MethodPost, endpoint: "/dapi/v1/order"}, weight: 0, orders: 1},
+		{r: &request{method: http.MethodPost, endpoint: "/dapi/v1/batchOrders", form: map[string][]string{"batchOrders": {`[{"symbol":"BTCUSD_PERP"},{"symbol":"ETHUSD_PERP"}]`}}}, weight: 5, orders: 2},
+		{r: &request{method: http.MethodGet, endpoint: "/futures/data/basis"}, weight: 0},
+	}
+	for _, test := range tests {
+		weight, orders := requestCost(test.r)
+		s.r().Equal(test.weight, weight, test.r.endpoint)
+		s.r().Equal(test.orders, orders, test.r.endpoint)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // Endpoints
@@ -22,6 +24,9 @@ var (
 	WebsocketPongTimeout = time.Second * 10
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = true
+	// WebsocketReconnectPolicy enables the reconnection of the Ws*Serve streams when the connection is lost,
+	// nil by default, see common.NewWsReconnectPolicy
+	WebsocketReconnectPolicy *common.WsReconnectPolicy
 	// UseTestnet switch all the WS streams from production to the testnet
 	UseTestnet = false
 	ProxyUrl   = ""
@@ -787,6 +792,12 @@ type WsUserDataHandler func(event *WsUserDataEvent)
 
 // WsUserDataServe serve user data handler with listen key
 func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return wsUserDataServe(wsServe, listenKey, handler, errHandler)
+}
+
+// wsUserDataServe connect to the user data stream using serve, wsServe or wsConnect
+func wsUserDataServe(serve func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error),
+	listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
 	cfg := newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
@@ -798,5 +809,5 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 		}
 		handler(event)
 	}
-	return wsServe(cfg, wsHandler, errHandler)
+	return serve(cfg, wsHandler, errHandler)
 }
@@ -0,0 +1,52 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// The Ws*Stream functions are the context based variants of the Ws*Serve functions, see common.Stream.
+// The other streams can be wrapped with common.Stream too.
+
+// WsKlineStream is similar to WsKlineServe, but it sends the events to a channel
+func WsKlineStream(ctx context.Context, symbol string, interval string, opts *common.StreamOptions) (<-chan *WsKlineEvent, <-chan error, error) {
+	return common.Stream(ctx, func(handler func(event *WsKlineEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsKlineServe(symbol, interval, handler, errHandler)
+	}, opts)
+}
+
+// WsAggTradeStream is similar to WsAggTradeServe, but it sends the events to a channel
+func WsAggTradeStream(ctx context.Context, symbol string, opts *common.StreamOptions) (<-chan *WsAggTradeEvent, <-chan error, error) {
+	return common.Stream(ctx, func(handler func(event *WsAggTradeEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAggTradeServe(symbol, handler, errHandler)
+	}, opts)
+}
+
+// WsMarkPriceStream is similar to WsMarkPriceServe, but it sends the events to a channel
+func WsMarkPriceStream(ctx context.Context, symbol string, opts *common.StreamOptions) (<-chan *WsMarkPriceEvent, <-chan error, error) {
+	return common.Stream(ctx, func(handler func(event *WsMarkPriceEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsMarkPriceServe(symbol, handler, errHandler)
+	}, opts)
+}
+
+// WsBookTickerStream is similar to WsBookTickerServe, but it sends the events to a channel
+func WsBookTickerStream(ctx context.Context, symbol string, opts *common.StreamOptions) (<-chan *WsBookTickerEvent, <-chan error, error) {
+	return common.Stream(ctx, func(handler func(event *WsBookTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsBookTickerServe(symbol, handler, errHandler)
+	}, opts)
+}
+
+// WsDiffDepthStream is similar to WsDiffDepthServe, but it sends the events to a channel
+func WsDiffDepthStream(ctx context.Context, symbol string, opts *common.StreamOptions) (<-chan *WsDepthEvent, <-chan error, error) {
+	return common.Stream(ctx, func(handler func(event *WsDepthEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsDiffDepthServe(symbol, handler, errHandler)
+	}, opts)
+}
+
+// WsUserDataStream is similar to WsUserDataServe, but it sends the events to a channel
+func WsUserDataStream(ctx context.Context, listenKey string, opts *common.StreamOptions) (<-chan *WsUserDataEvent, <-chan error, error) {
+	return common.Stream(ctx, func(handler func(event *WsUserDataEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServe(listenKey, handler, errHandler)
+	}, opts)
+}
@@ -40,6 +40,11 @@ func (s *DepthService) Do(ctx context.Context, opts ...RequestOption) (res *Dept
 	if err != nil {
 		return nil, err
 	}
+	return parseDepthResponse(data)
+}
+
+// parseDepthResponse parses a depth response with bids and asks as [price, quantity] arrays
+func parseDepthResponse(data []byte) (res *DepthResponse, err error) {
 	j, err := newJSON(data)
 	if err != nil {
 		return nil, err
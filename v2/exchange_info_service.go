@@ -85,6 +85,13 @@ type ExchangeInfo struct {
 	RateLimits      []RateLimit   `json:"rateLimits"`
 	ExchangeFilters []interface{} `json:"exchangeFilters"`
 	Symbols         []Symbol      `json:"symbols"`
+	Sors            []SOR         `json:"sors"`
+}
+
+// SOR define the symbols an order can be routed to by smart order routing
+type SOR struct {
+	BaseAsset string   `json:"baseAsset"`
+	Symbols   []string `json:"symbols"`
 }
 
 // RateLimit struct
@@ -109,6 +116,7 @@ type Symbol struct {
 	OrderTypes                 []string                 `json:"orderTypes"`
 	IcebergAllowed             bool                     `json:"icebergAllowed"`
 	OcoAllowed                 bool                     `json:"ocoAllowed"`
+	CancelReplaceAllowed       bool                     `json:"cancelReplaceAllowed"`
 	QuoteOrderQtyMarketAllowed bool                     `json:"quoteOrderQtyMarketAllowed"`
 	IsSpotTradingAllowed       bool                     `json:"isSpotTradingAllowed"`
 	IsMarginTradingAllowed     bool                     `json:"isMarginTradingAllowed"`
@@ -99,6 +99,12 @@ func (s *exchangeInfoServiceTestSuite) TestExchangeInfo() {
 				}],
 				"permissions": ["SPOT","MARGIN"]
 			}
+		],
+		"sors": [
+			{
+				"baseAsset": "BTC",
+				"symbols": ["BTCUSDT", "BTCUSDC"]
+			}
 		]
 	}`)
 	s.mockDo(data, nil)
@@ -152,6 +158,9 @@ func (s *exchangeInfoServiceTestSuite) TestExchangeInfo() {
 				Permissions: []string{"SPOT", "MARGIN"},
 			},
 		},
+		Sors: []SOR{
+			{BaseAsset: "BTC", Symbols: []string{"BTCUSDT", "BTCUSDC"}},
+		},
 	}
 	s.assertExchangeInfoEqual(ei, res)
 
@@ -285,6 +294,7 @@ func (s *exchangeInfoServiceTestSuite) assertExchangeInfoEqual(e, a *ExchangeInf
 	}
 
 	r.Equal(e.ExchangeFilters, a.ExchangeFilters, "ExchangeFilters")
+	r.Equal(e.Sors, a.Sors, "Sors")
 
 	for i, currentSymbol := range a.Symbols {
 		if a.Symbols[i].Symbol == e.Symbols[0].Symbol {
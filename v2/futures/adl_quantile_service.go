@@ -0,0 +1,48 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetADLQuantileService get the auto-deleveraging quantile of the positions
+type GetADLQuantileService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetADLQuantileService) Symbol(symbol string) *GetADLQuantileService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetADLQuantileService) Do(ctx context.Context, opts ...RequestOption) (res []*ADLQuantile, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/adlQuantile",
+		secType:  secTypeSigned,
+	}
+	if s.symbol != "" {
+		r.setParam("symbol", s.symbol)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*ADLQuantile{}, err
+	}
+	res = make([]*ADLQuantile, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*ADLQuantile{}, err
+	}
+	return res, nil
+}
+
+// ADLQuantile define the auto-deleveraging quantiles of the positions of a symbol, from 0 to 4.
+// The keys are LONG, SHORT and HEDGE in hedge mode, BOTH in one-way mode.
+type ADLQuantile struct {
+	Symbol      string           `json:"symbol"`
+	ADLQuantile map[string]int64 `json:"adlQuantile"`
+}
@@ -0,0 +1,74 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type adlQuantileServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestADLQuantileService(t *testing.T) {
+	suite.Run(t, new(adlQuantileServiceTestSuite))
+}
+
+func (s *adlQuantileServiceTestSuite) TestGetADLQuantile() {
+	data := []byte(`[
+		{
+			"symbol": "ETHUSDT",
+			"adlQuantile": {
+				"LONG": 3,
+				"SHORT": 3,
+				"HEDGE": 0
+			}
+		},
+		{
+			"symbol": "BTCUSDT",
+			"adlQuantile": {
+				"LONG": 1,
+				"SHORT": 2,
+				"BOTH": 0
+			}
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetADLQuantileService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*ADLQuantile{
+		{
+			Symbol:      "ETHUSDT",
+			ADLQuantile: map[string]int64{"LONG": 3, "SHORT": 3, "HEDGE": 0},
+		},
+		{
+			Symbol:      "BTCUSDT",
+			ADLQuantile: map[string]int64{"LONG": 1, "SHORT": 2, "BOTH": 0},
+		},
+	}, res)
+}
+
+func (s *adlQuantileServiceTestSuite) TestGetADLQuantileBySymbol() {
+	data := []byte(`[{"symbol": "BTCUSDT", "adlQuantile": {"BOTH": 4}}]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSDT"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("symbol", symbol)
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetADLQuantileService().Symbol(symbol).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*ADLQuantile{{Symbol: symbol, ADLQuantile: map[string]int64{"BOTH": 4}}}, res)
+}
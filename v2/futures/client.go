@@ -236,6 +236,7 @@ func NewClient(apiKey, secretKey string) *Client {
 		UserAgent:  "Binance/golang",
 		HTTPClient: http.DefaultClient,
 		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		UsedLimits: common.NewUsedLimits(),
 	}
 }
 
@@ -258,7 +259,8 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 		HTTPClient: &http.Client{
 			Transport: tr,
 		},
-		Logger: log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		UsedLimits: common.NewUsedLimits(),
 	}
 }
 
@@ -276,12 +278,39 @@ type Client struct {
 	Logger     *log.Logger
 	TimeOffset int64
 	do         doFunc
-}
 
-func (c *Client) debug(format string, v ...interface{}) {
+	// RateLimiter is an optional client side rate limiter, see NewRateLimiter
+	RateLimiter *common.RateLimiter
+	// RetryPolicy enables retries of failed requests, see common.NewRetryPolicy
+	RetryPolicy *common.RetryPolicy
+	// ClockSync keeps the timestamp of signed requests in sync with the server, see NewClockSync
+	ClockSync *common.ClockSync
+	// Interceptors are called in order around each request, see common.Interceptor
+	Interceptors []common.Interceptor
+	// StructuredLogger receives the logs of the client, the secrets are redacted.
+	// Logger is used at debug level when it is nil and Debug is set.
+	StructuredLogger common.Logger
+	// UsedLimits keeps the used weight and order count reported by the server, see common.UsedLimits
+	UsedLimits *common.UsedLimits
+}
+
+// logger return StructuredLogger, or Logger at debug level if Debug is set
+func (c *Client) logger() common.Logger {
+	if c.StructuredLogger != nil {
+		return c.StructuredLogger
+	}
 	if c.Debug {
-		c.Logger.Printf(format, v...)
+		return common.NewStdLogger(c.Logger, common.LogLevelDebug)
 	}
+	return common.NopLogger
+}
+
+// timeOffset return the offset of ClockSync once synced, TimeOffset otherwise
+func (c *Client) timeOffset() int64 {
+	if offset, ok := c.ClockSync.Offset(); ok {
+		return offset
+	}
+	return c.TimeOffset
 }
 
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
@@ -299,7 +328,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.timeOffset())
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -340,7 +369,8 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if queryString != "" {
 		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
 	}
-	c.debug("full url: %s, body: %s\n", fullURL, bodyString)
+	c.logger().Debug("request", "method", r.method, "url", common.RedactURL(fullURL),
+		"body", common.RedactQuery(bodyString), "header", common.RedactHeader(header))
 
 	r.fullURL = fullURL
 	r.header = header
@@ -349,28 +379,74 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	resynced := false
+	for attempt := 0; ; attempt++ {
+		var res *http.Response
+		data, res, err = c.callAPIOnce(ctx, r, attempt, opts...)
+		// re-issue a request rejected for its timestamp once, after resyncing the clock
+		if !resynced && r.secType == secTypeSigned && c.ClockSync.Resync(ctx, err) {
+			resynced = true
+			opts = nil
+			continue
+		}
+		delay, retry := c.RetryPolicy.Retry(attempt, r.method, res, err)
+		if !retry {
+			header = &http.Header{}
+			if res != nil {
+				header = &res.Header
+			}
+			return data, header, err
+		}
+		c.logger().Warn("retry request", "endpoint", r.endpoint, "attempt", attempt, "delay", delay, "error", err)
+		if err = common.Sleep(ctx, delay); err != nil {
+			return []byte{}, &http.Header{}, err
+		}
+		// request options have been applied by the first attempt
+		opts = nil
+	}
+}
+
+func (c *Client) callAPIOnce(ctx context.Context, r *request, attempt int, opts ...RequestOption) (data []byte, res *http.Response, err error) {
 	err = c.parseRequest(r, opts...)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, nil, err
 	}
 	req, err := http.NewRequest(r.method, r.fullURL, r.body)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, nil, err
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
-	c.debug("request: %#v\n", req)
+	weight, orders := requestCost(r)
+	if c.RateLimiter != nil {
+		if err = c.RateLimiter.Wait(ctx, weight, orders); err != nil {
+			return []byte{}, nil, err
+		}
+	}
+	call := common.NewCall(r.method, r.endpoint, r.query, r.form, weight, orders, attempt)
+	err = common.Invoke(ctx, c.Interceptors, call, func(ctx context.Context) (*http.Response, error) {
+		var sendErr error
+		data, res, sendErr = c.send(req.WithContext(ctx))
+		return res, sendErr
+	})
+	return data, res, err
+}
+
+// send sends the request and reads the response, an API error is returned for 4xx and 5xx responses
+func (c *Client) send(req *http.Request) (data []byte, res *http.Response, err error) {
 	f := c.do
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
-	res, err := f(req)
+	res, err = f(req)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, nil, err
 	}
+	c.RateLimiter.Update(res.Header)
+	c.UsedLimits.Update(res.Header)
 	data, err = io.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, res, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -380,22 +456,20 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 			err = cerr
 		}
 	}()
-	c.debug("response: %#v\n", res)
-	c.debug("response body: %s\n", string(data))
-	c.debug("response status code: %d\n", res.StatusCode)
+	c.logger().Debug("response", "endpoint", req.URL.Path, "status", res.StatusCode, "body", common.RedactedJSON(data))
 
 	if res.StatusCode >= http.StatusBadRequest {
 		apiErr := new(common.APIError)
 		e := json.Unmarshal(data, apiErr)
 		if e != nil {
-			c.debug("failed to unmarshal json: %s\n", e)
+			c.logger().Warn("failed to unmarshal api error", "error", e)
 		}
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, &res.Header, apiErr
+		return nil, res, apiErr
 	}
-	return data, &res.Header, nil
+	return data, res, nil
 }
 
 // SetApiEndpoint set api Endpoint
@@ -419,6 +493,14 @@ func (c *Client) NewSetServerTimeService() *SetServerTimeService {
 	return &SetServerTimeService{c: c}
 }
 
+// NewClockSync init a ClockSync polling the server time, assign it to Client.ClockSync
+// and call Start to keep the timestamp of signed requests in sync in the background
+func (c *Client) NewClockSync() *common.ClockSync {
+	return common.NewClockSync(func(ctx context.Context) (int64, error) {
+		return c.NewServerTimeService().Do(ctx)
+	})
+}
+
 // NewDepthService init depth service
 func (c *Client) NewDepthService() *DepthService {
 	return &DepthService{c: c}
@@ -489,6 +571,11 @@ func (c *Client) NewModifyBatchOrdersService() *ModifyBatchOrdersService {
 	return &ModifyBatchOrdersService{c: c}
 }
 
+// NewListOrderAmendmentsService init list order amendments service
+func (c *Client) NewListOrderAmendmentsService() *ListOrderAmendmentsService {
+	return &ListOrderAmendmentsService{c: c}
+}
+
 // NewGetOrderService init get order service
 func (c *Client) NewGetOrderService() *GetOrderService {
 	return &GetOrderService{c: c}
@@ -504,6 +591,11 @@ func (c *Client) NewCancelAllOpenOrdersService() *CancelAllOpenOrdersService {
 	return &CancelAllOpenOrdersService{c: c}
 }
 
+// NewCountdownCancelAllService init countdown cancel all service
+func (c *Client) NewCountdownCancelAllService() *CountdownCancelAllService {
+	return &CountdownCancelAllService{c: c}
+}
+
 // NewCancelMultipleOrdersService init cancel multiple orders service
 func (c *Client) NewCancelMultipleOrdersService() *CancelMultiplesOrdersService {
 	return &CancelMultiplesOrdersService{c: c}
@@ -557,6 +649,11 @@ func (c *Client) NewGetPositionRiskV3Service() *GetPositionRiskV3Service {
 	return &GetPositionRiskV3Service{c: c}
 }
 
+// NewGetADLQuantileService init get ADL quantile service
+func (c *Client) NewGetADLQuantileService() *GetADLQuantileService {
+	return &GetADLQuantileService{c: c}
+}
+
 // NewGetPositionMarginHistoryService init getting position margin history service
 func (c *Client) NewGetPositionMarginHistoryService() *GetPositionMarginHistoryService {
 	return &GetPositionMarginHistoryService{c: c}
@@ -760,3 +857,8 @@ func (c *Client) NewGetConvertStatusService() *ConvertStatusService {
 func (c *Client) NewApiTradingStatusService() *ApiTradingStatusService {
 	return &ApiTradingStatusService{c: c}
 }
+
+// NewRateLimitService init order rate limit service
+func (c *Client) NewRateLimitService() *RateLimitService {
+	return &RateLimitService{c: c}
+}
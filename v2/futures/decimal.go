@@ -0,0 +1,602 @@
+package futures
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (o *Order) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal, see common.ToDecimal
+func (o *Order) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal, see common.ToDecimal
+func (o *Order) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.ExecutedQuantity)
+}
+
+// CumQuantityDecimal return CumQuantity as a decimal, see common.ToDecimal
+func (o *Order) CumQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.CumQuantity)
+}
+
+// CumQuoteDecimal return CumQuote as a decimal, see common.ToDecimal
+func (o *Order) CumQuoteDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.CumQuote)
+}
+
+// StopPriceDecimal return StopPrice as a decimal, see common.ToDecimal
+func (o *Order) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.StopPrice)
+}
+
+// ActivatePriceDecimal return ActivatePrice as a decimal, see common.ToDecimal
+func (o *Order) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.ActivatePrice)
+}
+
+// PriceRateDecimal return PriceRate as a decimal, see common.ToDecimal
+func (o *Order) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.PriceRate)
+}
+
+// AvgPriceDecimal return AvgPrice as a decimal, see common.ToDecimal
+func (o *Order) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(o.AvgPrice)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) OrigQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) ExecutedQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.ExecutedQuantity)
+}
+
+// CumQuoteDecimal return CumQuote as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) CumQuoteDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.CumQuote)
+}
+
+// StopPriceDecimal return StopPrice as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.StopPrice)
+}
+
+// ActivatePriceDecimal return ActivatePrice as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) ActivatePriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.ActivatePrice)
+}
+
+// PriceRateDecimal return PriceRate as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) PriceRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.PriceRate)
+}
+
+// AvgPriceDecimal return AvgPrice as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) AvgPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.AvgPrice)
+}
+
+// CumQtyDecimal return CumQty as a decimal, see common.ToDecimal
+func (c *CreateOrderResponse) CumQtyDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(c.CumQty)
+}
+
+// CommissionDecimal return Commission as a decimal, see common.ToDecimal
+func (a *AccountTrade) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Commission)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (a *AccountTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal, see common.ToDecimal
+func (a *AccountTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Quantity)
+}
+
+// QuoteQuantityDecimal return QuoteQuantity as a decimal, see common.ToDecimal
+func (a *AccountTrade) QuoteQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.QuoteQuantity)
+}
+
+// RealizedPnlDecimal return RealizedPnl as a decimal, see common.ToDecimal
+func (a *AccountTrade) RealizedPnlDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.RealizedPnl)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (a *AggTrade) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal, see common.ToDecimal
+func (a *AggTrade) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Quantity)
+}
+
+// OpenDecimal return Open as a decimal, see common.ToDecimal
+func (k *Kline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.Open)
+}
+
+// HighDecimal return High as a decimal, see common.ToDecimal
+func (k *Kline) HighDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.High)
+}
+
+// LowDecimal return Low as a decimal, see common.ToDecimal
+func (k *Kline) LowDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.Low)
+}
+
+// CloseDecimal return Close as a decimal, see common.ToDecimal
+func (k *Kline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.Close)
+}
+
+// VolumeDecimal return Volume as a decimal, see common.ToDecimal
+func (k *Kline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.Volume)
+}
+
+// QuoteAssetVolumeDecimal return QuoteAssetVolume as a decimal, see common.ToDecimal
+func (k *Kline) QuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.QuoteAssetVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal return TakerBuyBaseAssetVolume as a decimal, see common.ToDecimal
+func (k *Kline) TakerBuyBaseAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal return TakerBuyQuoteAssetVolume as a decimal, see common.ToDecimal
+func (k *Kline) TakerBuyQuoteAssetVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(k.TakerBuyQuoteAssetVolume)
+}
+
+// OpenDecimal return Open as a decimal, see common.ToDecimal
+func (w *WsKline) OpenDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Open)
+}
+
+// CloseDecimal return Close as a decimal, see common.ToDecimal
+func (w *WsKline) CloseDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Close)
+}
+
+// HighDecimal return High as a decimal, see common.ToDecimal
+func (w *WsKline) HighDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.High)
+}
+
+// LowDecimal return Low as a decimal, see common.ToDecimal
+func (w *WsKline) LowDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Low)
+}
+
+// VolumeDecimal return Volume as a decimal, see common.ToDecimal
+func (w *WsKline) VolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Volume)
+}
+
+// QuoteVolumeDecimal return QuoteVolume as a decimal, see common.ToDecimal
+func (w *WsKline) QuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.QuoteVolume)
+}
+
+// ActiveBuyVolumeDecimal return ActiveBuyVolume as a decimal, see common.ToDecimal
+func (w *WsKline) ActiveBuyVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.ActiveBuyVolume)
+}
+
+// ActiveBuyQuoteVolumeDecimal return ActiveBuyQuoteVolume as a decimal, see common.ToDecimal
+func (w *WsKline) ActiveBuyQuoteVolumeDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.ActiveBuyQuoteVolume)
+}
+
+// BalanceDecimal return Balance as a decimal, see common.ToDecimal
+func (b *Balance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.Balance)
+}
+
+// CrossWalletBalanceDecimal return CrossWalletBalance as a decimal, see common.ToDecimal
+func (b *Balance) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.CrossWalletBalance)
+}
+
+// CrossUnPnlDecimal return CrossUnPnl as a decimal, see common.ToDecimal
+func (b *Balance) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.CrossUnPnl)
+}
+
+// AvailableBalanceDecimal return AvailableBalance as a decimal, see common.ToDecimal
+func (b *Balance) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.AvailableBalance)
+}
+
+// MaxWithdrawAmountDecimal return MaxWithdrawAmount as a decimal, see common.ToDecimal
+func (b *Balance) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.MaxWithdrawAmount)
+}
+
+// InitialMarginDecimal return InitialMargin as a decimal, see common.ToDecimal
+func (a *AccountAsset) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.InitialMargin)
+}
+
+// MaintMarginDecimal return MaintMargin as a decimal, see common.ToDecimal
+func (a *AccountAsset) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.MaintMargin)
+}
+
+// MarginBalanceDecimal return MarginBalance as a decimal, see common.ToDecimal
+func (a *AccountAsset) MarginBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.MarginBalance)
+}
+
+// MaxWithdrawAmountDecimal return MaxWithdrawAmount as a decimal, see common.ToDecimal
+func (a *AccountAsset) MaxWithdrawAmountDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.MaxWithdrawAmount)
+}
+
+// OpenOrderInitialMarginDecimal return OpenOrderInitialMargin as a decimal, see common.ToDecimal
+func (a *AccountAsset) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.OpenOrderInitialMargin)
+}
+
+// PositionInitialMarginDecimal return PositionInitialMargin as a decimal, see common.ToDecimal
+func (a *AccountAsset) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal return UnrealizedProfit as a decimal, see common.ToDecimal
+func (a *AccountAsset) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.UnrealizedProfit)
+}
+
+// WalletBalanceDecimal return WalletBalance as a decimal, see common.ToDecimal
+func (a *AccountAsset) WalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.WalletBalance)
+}
+
+// CrossWalletBalanceDecimal return CrossWalletBalance as a decimal, see common.ToDecimal
+func (a *AccountAsset) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.CrossWalletBalance)
+}
+
+// CrossUnPnlDecimal return CrossUnPnl as a decimal, see common.ToDecimal
+func (a *AccountAsset) CrossUnPnlDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.CrossUnPnl)
+}
+
+// AvailableBalanceDecimal return AvailableBalance as a decimal, see common.ToDecimal
+func (a *AccountAsset) AvailableBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.AvailableBalance)
+}
+
+// LeverageDecimal return Leverage as a decimal, see common.ToDecimal
+func (a *AccountPosition) LeverageDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Leverage)
+}
+
+// InitialMarginDecimal return InitialMargin as a decimal, see common.ToDecimal
+func (a *AccountPosition) InitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.InitialMargin)
+}
+
+// MaintMarginDecimal return MaintMargin as a decimal, see common.ToDecimal
+func (a *AccountPosition) MaintMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.MaintMargin)
+}
+
+// OpenOrderInitialMarginDecimal return OpenOrderInitialMargin as a decimal, see common.ToDecimal
+func (a *AccountPosition) OpenOrderInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.OpenOrderInitialMargin)
+}
+
+// PositionInitialMarginDecimal return PositionInitialMargin as a decimal, see common.ToDecimal
+func (a *AccountPosition) PositionInitialMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.PositionInitialMargin)
+}
+
+// UnrealizedProfitDecimal return UnrealizedProfit as a decimal, see common.ToDecimal
+func (a *AccountPosition) UnrealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.UnrealizedProfit)
+}
+
+// EntryPriceDecimal return EntryPrice as a decimal, see common.ToDecimal
+func (a *AccountPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.EntryPrice)
+}
+
+// MaxNotionalDecimal return MaxNotional as a decimal, see common.ToDecimal
+func (a *AccountPosition) MaxNotionalDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.MaxNotional)
+}
+
+// NotionalDecimal return Notional as a decimal, see common.ToDecimal
+func (a *AccountPosition) NotionalDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.Notional)
+}
+
+// BidNotionalDecimal return BidNotional as a decimal, see common.ToDecimal
+func (a *AccountPosition) BidNotionalDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.BidNotional)
+}
+
+// AskNotionalDecimal return AskNotional as a decimal, see common.ToDecimal
+func (a *AccountPosition) AskNotionalDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(a.AskNotional)
+}
+
+// EntryPriceDecimal return EntryPrice as a decimal, see common.ToDecimal
+func (p *PositionRisk) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.EntryPrice)
+}
+
+// BreakEvenPriceDecimal return BreakEvenPrice as a decimal, see common.ToDecimal
+func (p *PositionRisk) BreakEvenPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.BreakEvenPrice)
+}
+
+// IsolatedMarginDecimal return IsolatedMargin as a decimal, see common.ToDecimal
+func (p *PositionRisk) IsolatedMarginDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.IsolatedMargin)
+}
+
+// LeverageDecimal return Leverage as a decimal, see common.ToDecimal
+func (p *PositionRisk) LeverageDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.Leverage)
+}
+
+// LiquidationPriceDecimal return LiquidationPrice as a decimal, see common.ToDecimal
+func (p *PositionRisk) LiquidationPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.LiquidationPrice)
+}
+
+// MarkPriceDecimal return MarkPrice as a decimal, see common.ToDecimal
+func (p *PositionRisk) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.MarkPrice)
+}
+
+// MaxNotionalValueDecimal return MaxNotionalValue as a decimal, see common.ToDecimal
+func (p *PositionRisk) MaxNotionalValueDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.MaxNotionalValue)
+}
+
+// UnRealizedProfitDecimal return UnRealizedProfit as a decimal, see common.ToDecimal
+func (p *PositionRisk) UnRealizedProfitDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.UnRealizedProfit)
+}
+
+// NotionalDecimal return Notional as a decimal, see common.ToDecimal
+func (p *PositionRisk) NotionalDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.Notional)
+}
+
+// MarkPriceDecimal return MarkPrice as a decimal, see common.ToDecimal
+func (p *PremiumIndex) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.MarkPrice)
+}
+
+// IndexPriceDecimal return IndexPrice as a decimal, see common.ToDecimal
+func (p *PremiumIndex) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.IndexPrice)
+}
+
+// EstimatedSettlePriceDecimal return EstimatedSettlePrice as a decimal, see common.ToDecimal
+func (p *PremiumIndex) EstimatedSettlePriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.EstimatedSettlePrice)
+}
+
+// LastFundingRateDecimal return LastFundingRate as a decimal, see common.ToDecimal
+func (p *PremiumIndex) LastFundingRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.LastFundingRate)
+}
+
+// InterestRateDecimal return InterestRate as a decimal, see common.ToDecimal
+func (p *PremiumIndex) InterestRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(p.InterestRate)
+}
+
+// FundingRateDecimal return FundingRate as a decimal, see common.ToDecimal
+func (f *FundingRate) FundingRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(f.FundingRate)
+}
+
+// MarkPriceDecimal return MarkPrice as a decimal, see common.ToDecimal
+func (f *FundingRate) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(f.MarkPrice)
+}
+
+// BidPriceDecimal return BidPrice as a decimal, see common.ToDecimal
+func (b *BookTicker) BidPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.BidPrice)
+}
+
+// BidQuantityDecimal return BidQuantity as a decimal, see common.ToDecimal
+func (b *BookTicker) BidQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.BidQuantity)
+}
+
+// AskPriceDecimal return AskPrice as a decimal, see common.ToDecimal
+func (b *BookTicker) AskPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.AskPrice)
+}
+
+// AskQuantityDecimal return AskQuantity as a decimal, see common.ToDecimal
+func (b *BookTicker) AskQuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(b.AskQuantity)
+}
+
+// PriceDecimal return Price as a decimal, see common.ToDecimal
+func (e *WsAggTradeEvent) PriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal, see common.ToDecimal
+func (e *WsAggTradeEvent) QuantityDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.Quantity)
+}
+
+// MarkPriceDecimal return MarkPrice as a decimal, see common.ToDecimal
+func (e *WsMarkPriceEvent) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.MarkPrice)
+}
+
+// IndexPriceDecimal return IndexPrice as a decimal, see common.ToDecimal
+func (e *WsMarkPriceEvent) IndexPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.IndexPrice)
+}
+
+// EstimatedSettlePriceDecimal return EstimatedSettlePrice as a decimal, see common.ToDecimal
+func (e *WsMarkPriceEvent) EstimatedSettlePriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.EstimatedSettlePrice)
+}
+
+// FundingRateDecimal return FundingRate as a decimal, see common.ToDecimal
+func (e *WsMarkPriceEvent) FundingRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.FundingRate)
+}
+
+// BestBidPriceDecimal return BestBidPrice as a decimal, see common.ToDecimal
+func (e *WsBookTickerEvent) BestBidPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.BestBidPrice)
+}
+
+// BestBidQtyDecimal return BestBidQty as a decimal, see common.ToDecimal
+func (e *WsBookTickerEvent) BestBidQtyDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.BestBidQty)
+}
+
+// BestAskPriceDecimal return BestAskPrice as a decimal, see common.ToDecimal
+func (e *WsBookTickerEvent) BestAskPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.BestAskPrice)
+}
+
+// BestAskQtyDecimal return BestAskQty as a decimal, see common.ToDecimal
+func (e *WsBookTickerEvent) BestAskQtyDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(e.BestAskQty)
+}
+
+// OriginalQtyDecimal return OriginalQty as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) OriginalQtyDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.OriginalQty)
+}
+
+// OriginalPriceDecimal return OriginalPrice as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) OriginalPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.OriginalPrice)
+}
+
+// AveragePriceDecimal return AveragePrice as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) AveragePriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.AveragePrice)
+}
+
+// StopPriceDecimal return StopPrice as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) StopPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.StopPrice)
+}
+
+// LastFilledQtyDecimal return LastFilledQty as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) LastFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.LastFilledQty)
+}
+
+// AccumulatedFilledQtyDecimal return AccumulatedFilledQty as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) AccumulatedFilledQtyDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.AccumulatedFilledQty)
+}
+
+// LastFilledPriceDecimal return LastFilledPrice as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) LastFilledPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.LastFilledPrice)
+}
+
+// CommissionDecimal return Commission as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) CommissionDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Commission)
+}
+
+// BidsNotionalDecimal return BidsNotional as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) BidsNotionalDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.BidsNotional)
+}
+
+// AsksNotionalDecimal return AsksNotional as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) AsksNotionalDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.AsksNotional)
+}
+
+// ActivationPriceDecimal return ActivationPrice as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) ActivationPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.ActivationPrice)
+}
+
+// CallbackRateDecimal return CallbackRate as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) CallbackRateDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.CallbackRate)
+}
+
+// RealizedPnLDecimal return RealizedPnL as a decimal, see common.ToDecimal
+func (w *WsOrderTradeUpdate) RealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.RealizedPnL)
+}
+
+// BalanceDecimal return Balance as a decimal, see common.ToDecimal
+func (w *WsBalance) BalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Balance)
+}
+
+// CrossWalletBalanceDecimal return CrossWalletBalance as a decimal, see common.ToDecimal
+func (w *WsBalance) CrossWalletBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.CrossWalletBalance)
+}
+
+// ChangeBalanceDecimal return ChangeBalance as a decimal, see common.ToDecimal
+func (w *WsBalance) ChangeBalanceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.ChangeBalance)
+}
+
+// AmountDecimal return Amount as a decimal, see common.ToDecimal
+func (w *WsPosition) AmountDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.Amount)
+}
+
+// EntryPriceDecimal return EntryPrice as a decimal, see common.ToDecimal
+func (w *WsPosition) EntryPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.EntryPrice)
+}
+
+// MarkPriceDecimal return MarkPrice as a decimal, see common.ToDecimal
+func (w *WsPosition) MarkPriceDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.MarkPrice)
+}
+
+// UnrealizedPnLDecimal return UnrealizedPnL as a decimal, see common.ToDecimal
+func (w *WsPosition) UnrealizedPnLDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.UnrealizedPnL)
+}
+
+// MaintenanceMarginRequiredDecimal return MaintenanceMarginRequired as a decimal, see common.ToDecimal
+func (w *WsPosition) MaintenanceMarginRequiredDecimal() (decimal.Decimal, error) {
+	return common.ToDecimal(w.MaintenanceMarginRequired)
+}
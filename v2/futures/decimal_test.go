@@ -0,0 +1,34 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimalAccessors(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   func() (decimal.Decimal, error)
+		want    string
+		wantErr bool
+	}{
+		{name: "price", value: (&Order{Price: "0.10000001"}).PriceDecimal, want: "0.10000001"},
+		{name: "empty quantity", value: (&Order{CumQuantity: ""}).CumQuantityDecimal, want: "0"},
+		{name: "malformed quantity", value: (&Order{OrigQuantity: "1.5x"}).OrigQuantityDecimal, wantErr: true},
+		{name: "kline close", value: (&Kline{Close: "9639.0"}).CloseDecimal, want: "9639"},
+		{name: "malformed kline open", value: (&Kline{Open: "NaN"}).OpenDecimal, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := tt.value()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, d.String())
+		})
+	}
+}
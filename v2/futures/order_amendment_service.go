@@ -0,0 +1,115 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// ListOrderAmendmentsService list the amendments of the orders modified by ModifyOrderService
+type ListOrderAmendmentsService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	startTime         *int64
+	endTime           *int64
+	limit             *int
+}
+
+// Symbol set symbol
+func (s *ListOrderAmendmentsService) Symbol(symbol string) *ListOrderAmendmentsService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID, one of OrderID or OrigClientOrderID is required
+func (s *ListOrderAmendmentsService) OrderID(orderID int64) *ListOrderAmendmentsService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *ListOrderAmendmentsService) OrigClientOrderID(origClientOrderID string) *ListOrderAmendmentsService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// StartTime set startTime
+func (s *ListOrderAmendmentsService) StartTime(startTime int64) *ListOrderAmendmentsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListOrderAmendmentsService) EndTime(endTime int64) *ListOrderAmendmentsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit, default 50, max 100
+func (s *ListOrderAmendmentsService) Limit(limit int) *ListOrderAmendmentsService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListOrderAmendmentsService) Do(ctx context.Context, opts ...RequestOption) (res []*OrderAmendment, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/orderAmendment",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.setParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.setParam("origClientOrderId", *s.origClientOrderID)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OrderAmendment{}, err
+	}
+	res = make([]*OrderAmendment, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OrderAmendment{}, err
+	}
+	return res, nil
+}
+
+// OrderAmendment define an amendment of an order
+type OrderAmendment struct {
+	AmendmentID   int64              `json:"amendmentId"`
+	Symbol        string             `json:"symbol"`
+	Pair          string             `json:"pair"`
+	OrderID       int64              `json:"orderId"`
+	ClientOrderID string             `json:"clientOrderId"`
+	Time          int64              `json:"time"`
+	Amendment     OrderAmendmentDiff `json:"amendment"`
+	PriceMatch    PriceMatchType     `json:"priceMatch"`
+}
+
+// OrderAmendmentDiff define the price and quantity of the order before and after the amendment
+type OrderAmendmentDiff struct {
+	Price   OrderAmendmentValue `json:"price"`
+	OrigQty OrderAmendmentValue `json:"origQty"`
+	// Count is the number of amendments of the order so far
+	Count int `json:"count"`
+}
+
+// OrderAmendmentValue define a value before and after the amendment
+type OrderAmendmentValue struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
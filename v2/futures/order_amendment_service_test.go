@@ -0,0 +1,101 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderAmendmentServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderAmendmentService(t *testing.T) {
+	suite.Run(t, new(orderAmendmentServiceTestSuite))
+}
+
+func (s *orderAmendmentServiceTestSuite) TestListOrderAmendments() {
+	data := []byte(`[
+		{
+			"amendmentId": 5363,
+			"symbol": "BTCUSDT",
+			"pair": "BTCUSDT",
+			"orderId": 20072994037,
+			"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+			"time": 1629184560899,
+			"amendment": {
+				"price": {
+					"before": "30004",
+					"after": "30003.2"
+				},
+				"origQty": {
+					"before": "1",
+					"after": "1"
+				},
+				"count": 3
+			},
+			"priceMatch": "NONE"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSDT"
+	orderID := int64(20072994037)
+	startTime := int64(1629184000000)
+	endTime := int64(1629185000000)
+	limit := 10
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":    symbol,
+			"orderId":   orderID,
+			"startTime": startTime,
+			"endTime":   endTime,
+			"limit":     limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListOrderAmendmentsService().Symbol(symbol).OrderID(orderID).
+		StartTime(startTime).EndTime(endTime).Limit(limit).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*OrderAmendment{
+		{
+			AmendmentID:   5363,
+			Symbol:        "BTCUSDT",
+			Pair:          "BTCUSDT",
+			OrderID:       20072994037,
+			ClientOrderID: "LJ9R4QZDihCaS8UAOOLpgW",
+			Time:          1629184560899,
+			Amendment: OrderAmendmentDiff{
+				Price:   OrderAmendmentValue{Before: "30004", After: "30003.2"},
+				OrigQty: OrderAmendmentValue{Before: "1", After: "1"},
+				Count:   3,
+			},
+			PriceMatch: "NONE",
+		},
+	}, res)
+}
+
+func (s *orderAmendmentServiceTestSuite) TestListOrderAmendmentsByClientOrderID() {
+	data := []byte(`[]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSDT"
+	origClientOrderID := "LJ9R4QZDihCaS8UAOOLpgW"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":            symbol,
+			"origClientOrderId": origClientOrderID,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListOrderAmendmentsService().Symbol(symbol).
+		OrigClientOrderID(origClientOrderID).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Empty(res)
+}
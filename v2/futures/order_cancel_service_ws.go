@@ -1,6 +1,7 @@
 package futures
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
@@ -77,6 +78,7 @@ type OrderCancelWsResponse struct {
 // OrderCancelWsService cancel order
 type OrderCancelWsService struct {
 	c          websocket.Client
+	session    *websocket.Session
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
@@ -105,7 +107,7 @@ func NewOrderCancelWsService(apiKey, secretKey string) (*OrderCancelWsService, e
 
 // Do - sends 'order.cancel' request
 func (s *OrderCancelWsService) Do(requestID string, request *OrderCancelRequest) error {
-	rawData, err := websocket.CreateRequest(
+	rawData, err := s.session.CreateRequest(
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
@@ -129,7 +131,20 @@ func (s *OrderCancelWsService) Do(requestID string, request *OrderCancelRequest)
 
 // SyncDo - sends 'order.cancel' request and receives response
 func (s *OrderCancelWsService) SyncDo(requestID string, request *OrderCancelRequest) (*OrderCancelWsResponse, error) {
-	rawData, err := websocket.CreateRequest(
+	return s.syncDo(requestID, request, func(rawData []byte) ([]byte, error) {
+		return s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	})
+}
+
+// SyncDoContext - sends 'order.cancel' request and receives response until ctx is done
+func (s *OrderCancelWsService) SyncDoContext(ctx context.Context, requestID string, request *OrderCancelRequest) (*OrderCancelWsResponse, error) {
+	return s.syncDo(requestID, request, func(rawData []byte) ([]byte, error) {
+		return websocket.WriteSyncContext(ctx, s.c, requestID, rawData)
+	})
+}
+
+func (s *OrderCancelWsService) syncDo(requestID string, request *OrderCancelRequest, writeSync func(rawData []byte) ([]byte, error)) (*OrderCancelWsResponse, error) {
+	rawData, err := s.session.CreateRequest(
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
@@ -144,7 +159,7 @@ func (s *OrderCancelWsService) SyncDo(requestID string, request *OrderCancelRequ
 		return nil, err
 	}
 
-	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	response, err := writeSync(rawData)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package futures
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -20,7 +21,7 @@ func (s *orderCancelServiceWsTestSuite) SetupTest() {
 	s.requestID = "e2a85d9f-07a5-4f94-8d5f-789dc3deb098"
 
 	s.ctrl = gomock.NewController(s.T())
-	s.client = mock.NewMockClient(s.ctrl)
+	s.client = mock.NewMockContextClient(s.ctrl)
 
 	s.orderCancel = &OrderCancelWsService{
 		c:         s.client,
@@ -44,7 +45,7 @@ type orderCancelServiceWsTestSuite struct {
 	timeOffset int64
 
 	ctrl   *gomock.Controller
-	client *mock.MockClient
+	client *mock.MockContextClient
 
 	requestID string
 
@@ -125,6 +126,31 @@ func (s *orderCancelServiceWsTestSuite) TestOrderCancelSync() {
 	s.Equal(s.requestID, response.Result.ClientOrderID)
 }
 
+func (s *orderCancelServiceWsTestSuite) TestOrderCancelSyncContext() {
+	s.reset(s.apiKey, s.secretKey, s.signedKey, s.timeOffset)
+
+	orderCancelResponse := OrderCancelWsResponse{
+		Id:     s.requestID,
+		Status: 200,
+		Result: CancelOrderResult{
+			CancelOrderResponse{
+				ClientOrderID: s.requestID,
+			},
+		},
+	}
+
+	rawResponseData, err := json.Marshal(orderCancelResponse)
+	s.NoError(err)
+
+	ctx := context.Background()
+	s.client.EXPECT().WriteSyncContext(ctx, s.requestID, gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	req := s.orderCancelRequest
+	response, err := s.orderCancel.SyncDoContext(ctx, s.requestID, req)
+	s.Require().NoError(err)
+	s.Equal(s.requestID, response.Result.ClientOrderID)
+}
+
 func (s *orderCancelServiceWsTestSuite) TestOrderCancelSync_EmptyRequestID() {
 	s.reset(s.apiKey, s.secretKey, s.signedKey, s.timeOffset)
 
@@ -1,6 +1,7 @@
 package futures
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 // OrderPlaceWsService creates order
 type OrderPlaceWsService struct {
 	c          websocket.Client
+	session    *websocket.Session
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
@@ -175,6 +177,8 @@ type CreateOrderWsResponse struct {
 	Id     string            `json:"id"`
 	Status int               `json:"status"`
 	Result CreateOrderResult `json:"result"`
+	// RateLimits is the usage of the rate limits after the request
+	RateLimits []common.UsedRateLimit `json:"rateLimits,omitempty"`
 
 	// error response
 	Error *common.APIError `json:"error,omitempty"`
@@ -239,7 +243,7 @@ func (s *OrderPlaceWsRequest) buildParams() params {
 
 // Do - sends 'order.place' request
 func (s *OrderPlaceWsService) Do(requestID string, request *OrderPlaceWsRequest) error {
-	rawData, err := websocket.CreateRequest(
+	rawData, err := s.session.CreateRequest(
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
@@ -263,7 +267,20 @@ func (s *OrderPlaceWsService) Do(requestID string, request *OrderPlaceWsRequest)
 
 // SyncDo - sends 'order.place' request and receives response
 func (s *OrderPlaceWsService) SyncDo(requestID string, request *OrderPlaceWsRequest) (*CreateOrderWsResponse, error) {
-	rawData, err := websocket.CreateRequest(
+	return s.syncDo(requestID, request, func(rawData []byte) ([]byte, error) {
+		return s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	})
+}
+
+// SyncDoContext - sends 'order.place' request and receives response until ctx is done
+func (s *OrderPlaceWsService) SyncDoContext(ctx context.Context, requestID string, request *OrderPlaceWsRequest) (*CreateOrderWsResponse, error) {
+	return s.syncDo(requestID, request, func(rawData []byte) ([]byte, error) {
+		return websocket.WriteSyncContext(ctx, s.c, requestID, rawData)
+	})
+}
+
+func (s *OrderPlaceWsService) syncDo(requestID string, request *OrderPlaceWsRequest, writeSync func(rawData []byte) ([]byte, error)) (*CreateOrderWsResponse, error) {
+	rawData, err := s.session.CreateRequest(
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
@@ -278,7 +295,7 @@ func (s *OrderPlaceWsService) SyncDo(requestID string, request *OrderPlaceWsRequ
 		return nil, err
 	}
 
-	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	response, err := writeSync(rawData)
 	if err != nil {
 		return nil, err
 	}
@@ -217,6 +217,13 @@ func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Test send test api to check if the request is valid, the order is not sent to the matching engine.
+// Unlike spot, the futures test endpoint does not compute the commission rates, see CommissionRateService.
+func (s *CreateOrderService) Test(ctx context.Context, opts ...RequestOption) (err error) {
+	_, _, err = s.createOrder(ctx, "/fapi/v1/order/test", opts...)
+	return err
+}
+
 // CreateOrderResponse define create order response
 type CreateOrderResponse struct {
 	Symbol                  string           `json:"symbol"`                      //
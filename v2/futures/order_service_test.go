@@ -118,6 +118,20 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 	s.assertCreateOrderResponseEqual(e, res)
 }
 
+func (s *orderServiceTestSuite) TestCreateOrderTest() {
+	data := []byte(`{}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		s.r().Equal("BTCUSDT", r.form.Get("symbol"))
+		s.r().Equal("MARKET", r.form.Get("type"))
+	})
+	err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("0.01").Test(newContext())
+	s.r().NoError(err)
+}
+
 func (s *orderServiceTestSuite) TestCreateOrderId() {
 	data := []byte(`{
 		"cumQuote": "0",
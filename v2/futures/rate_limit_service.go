@@ -0,0 +1,31 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// RateLimitService get the order rate limits of the account
+type RateLimitService struct {
+	c *Client
+}
+
+// Do send request
+func (s *RateLimitService) Do(ctx context.Context, opts ...RequestOption) (res []*RateLimit, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/fapi/v1/rateLimit/order",
+		secType:  secTypeSigned,
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*RateLimit{}, err
+	}
+	res = make([]*RateLimit, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*RateLimit{}, err
+	}
+	return res, nil
+}
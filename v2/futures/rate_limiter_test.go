@@ -0,0 +1,52 @@
+package futures
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type rateLimiterTestSuite struct {
+	baseTestSuite
+}
+
+func TestRateLimiter(t *testing.T) {
+	suite.Run(t, new(rateLimiterTestSuite))
+}
+
+func (s *rateLimiterTestSuite) TestRequestCost() {
+	tests := []struct {
+		r      *request
+		weight int64
+		orders int64
+	}{
+		{r: &request{method: http.MethodGet, endpoint: "/fapi/v1/depth", query: map[string][]string{"limit": {"100"}}}, weight: 5},
+		{r: &request{method: http.MethodGet, endpoint: "/fapi/v1/klines", query: map[string][]string{"limit": {"1500"}}}, weight: 10},
+		{r: &request{method: http.MethodGet, endpoint: "/fapi/v1/openOrders"}, weight: 40},
+		{r: &request{method: http.MethodPost, endpoint: "/fapi/v1/order"}, weight: 0, orders: 1},
+		{r: &request{method: http.MethodPost, endpoint: "/fapi/v1/batchOrders", form: map[string][]string{"batchOrders": {`[{"symbol":"BTCUSDT"},{"symbol":"ETHUSDT"}]`}}}, weight: 5, orders: 2},
+		{r: &request{method: http.MethodGet, endpoint: "/fapi/v1/adlQuantile"}, weight: 5},
+		{r: &request{method: http.MethodGet, endpoint: "/futures/data/basis"}, weight: 0},
+	}
+	for _, test := range tests {
+		weight, orders := requestCost(test.r)
+		s.r().Equal(test.weight, weight, test.r.endpoint)
+		s.r().Equal(test.orders, orders, test.r.endpoint)
+	}
+}
+
+func (s *rateLimiterTestSuite) TestUsedLimits() {
+	res := newHTTPResponse([]byte(`{"serverTime":1499827319559}`), http.StatusOK)
+	res.Header = http.Header{}
+	res.Header.Set("X-Mbx-Used-Weight-1m", "20")
+	res.Header.Set("X-Mbx-Order-Count-1m", "3")
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(res, nil).Once()
+
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	s.r().NoError(err)
+	snapshot := s.client.UsedLimits.Snapshot()
+	s.r().Equal(int64(20), snapshot.UsedWeight["1M"].Used)
+	s.r().Equal(int64(3), snapshot.OrderCount["1M"].Used)
+}
@@ -0,0 +1,74 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// StreamClient subscribes to the market streams at runtime on a single connection,
+// the typed Subscribe* methods decode the payloads into the events of the Ws*Serve functions
+type StreamClient struct {
+	*common.StreamClient
+}
+
+// NewStreamClient init a StreamClient, Connect must be called before subscribing
+func NewStreamClient() *StreamClient {
+	endpoint := strings.TrimSuffix(getCombinedEndpoint(), "?streams=")
+	return &StreamClient{common.NewStreamClient(endpoint, getWsProxyUrl())}
+}
+
+// SubscribeKline subscribes to the klines of a symbol with an interval like 15m, 30s
+func (c *StreamClient) SubscribeKline(ctx context.Context, symbol string, interval string, handler WsKlineHandler) error {
+	stream := fmt.Sprintf("%s@kline_%s", strings.ToLower(symbol), interval)
+	return c.Subscribe(ctx, func(stream string, data []byte) error {
+		event := new(WsKlineEvent)
+		if err := json.Unmarshal(data, event); err != nil {
+			return err
+		}
+		handler(event)
+		return nil
+	}, stream)
+}
+
+// SubscribeAggTrade subscribes to the aggregate trades of a symbol
+func (c *StreamClient) SubscribeAggTrade(ctx context.Context, symbol string, handler WsAggTradeHandler) error {
+	stream := fmt.Sprintf("%s@aggTrade", strings.ToLower(symbol))
+	return c.Subscribe(ctx, func(stream string, data []byte) error {
+		event := new(WsAggTradeEvent)
+		if err := json.Unmarshal(data, event); err != nil {
+			return err
+		}
+		handler(event)
+		return nil
+	}, stream)
+}
+
+// SubscribeMarkPrice subscribes to the mark price and funding rate of a symbol
+func (c *StreamClient) SubscribeMarkPrice(ctx context.Context, symbol string, handler WsMarkPriceHandler) error {
+	stream := fmt.Sprintf("%s@markPrice", strings.ToLower(symbol))
+	return c.Subscribe(ctx, func(stream string, data []byte) error {
+		event := new(WsMarkPriceEvent)
+		if err := json.Unmarshal(data, event); err != nil {
+			return err
+		}
+		handler(event)
+		return nil
+	}, stream)
+}
+
+// SubscribeBookTicker subscribes to the best bid and ask of a symbol
+func (c *StreamClient) SubscribeBookTicker(ctx context.Context, symbol string, handler WsBookTickerHandler) error {
+	stream := fmt.Sprintf("%s@bookTicker", strings.ToLower(symbol))
+	return c.Subscribe(ctx, func(stream string, data []byte) error {
+		event := new(WsBookTickerEvent)
+		if err := json.Unmarshal(data, event); err != nil {
+			return err
+		}
+		handler(event)
+		return nil
+	}, stream)
+}
@@ -0,0 +1,39 @@
+package futures
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// UserDataStream keeps a user data stream connected: the listen key is kept alive and renewed,
+// and the stream is reconnected when the connection is lost, see OnGap to reconcile the missed events
+type UserDataStream = common.UserDataStream
+
+// NewUserDataStream init a user data stream, call Serve to connect
+func (c *Client) NewUserDataStream(handler WsUserDataHandler) *UserDataStream {
+	return common.NewUserDataStream(
+		func(ctx context.Context) (string, error) {
+			return c.NewStartUserStreamService().Do(ctx)
+		},
+		func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		func(ctx context.Context, listenKey string) error {
+			return c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		userDataStreamServe(handler))
+}
+
+// userDataStreamServe connect to the user data stream of a listen key, the stream reconnects
+// with a new listen key and reports the gap, WebsocketReconnectPolicy is not used
+func userDataStreamServe(handler WsUserDataHandler) common.UserDataServeFunc {
+	return func(listenKey string, expire func(), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return wsUserDataServe(wsConnect, listenKey, func(event *WsUserDataEvent) {
+			if event.Event == UserDataEventTypeListenKeyExpired {
+				expire()
+			}
+			handler(event)
+		}, errHandler)
+	}
+}
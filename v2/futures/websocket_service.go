@@ -9,6 +9,8 @@ import (
 
 	"github.com/bitly/go-simplejson"
 	"github.com/gorilla/websocket"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // Endpoints
@@ -28,6 +30,9 @@ var (
 	WebsocketPongTimeout = time.Second * 10
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = true
+	// WebsocketReconnectPolicy enables the reconnection of the Ws*Serve streams when the connection is lost,
+	// nil by default, see common.NewWsReconnectPolicy
+	WebsocketReconnectPolicy *common.WsReconnectPolicy
 	// UseTestnet switch all the WS streams from production to the testnet
 	UseTestnet = false
 	// WebsocketTimeoutReadWriteConnection is an interval for sending ping/pong messages if WebsocketKeepalive is enabled
@@ -1184,6 +1189,12 @@ type WsUserDataHandler func(event *WsUserDataEvent)
 
 // WsUserDataServe serve user data handler with listen key
 func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return wsUserDataServe(wsServe, listenKey, handler, errHandler)
+}
+
+// wsUserDataServe connect to the user data stream using serve, wsServe or wsConnect
+func wsUserDataServe(serve func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error),
+	listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
 	cfg := newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
@@ -1195,7 +1206,7 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 		}
 		handler(event)
 	}
-	return wsServe(cfg, wsHandler, errHandler)
+	return serve(cfg, wsHandler, errHandler)
 }
 
 // WsApiInitReadWriteConn create and serve connection
@@ -0,0 +1,141 @@
+package futures
+
+import (
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// WsApiClient define websocket API client, all services created by the client share a single connection
+type WsApiClient struct {
+	c          websocket.Client
+	session    *websocket.Session
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+}
+
+// NewWsApiClient init WsApiClient
+func NewWsApiClient(apiKey, secretKey string) (*WsApiClient, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WsApiClient{
+		c:         client,
+		session:   websocket.NewSession(client),
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+	}, nil
+}
+
+// NewWsApiClient init WsApiClient with the keys of the client.
+// The logger and UsedLimits of the client are shared, the rateLimits of the websocket API responses update c.UsedLimits.
+func (c *Client) NewWsApiClient() (*WsApiClient, error) {
+	client, err := NewWsApiClient(c.APIKey, c.SecretKey)
+	if err != nil {
+		return nil, err
+	}
+	client.KeyType = c.KeyType
+	client.TimeOffset = c.TimeOffset
+	client.SetLogger(c.logger())
+	client.SetUsedLimits(c.UsedLimits)
+	return client, nil
+}
+
+// SessionLogon authenticates the connection with the api key of the client, KeyType must be ED25519.
+// Signed requests are then sent without signature, the session is logged on again after a reconnect.
+func (c *WsApiClient) SessionLogon(requestID string) (*websocket.SessionResponse, error) {
+	return c.session.Logon(websocket.NewRequestData(
+		requestID,
+		c.ApiKey,
+		c.SecretKey,
+		c.TimeOffset,
+		c.KeyType,
+	))
+}
+
+// SessionStatus returns the authentication status of the connection
+func (c *WsApiClient) SessionStatus(requestID string) (*websocket.SessionResponse, error) {
+	return c.session.Status(requestID)
+}
+
+// SessionLogout forgets the api key of the connection, signed requests are signed again
+func (c *WsApiClient) SessionLogout(requestID string) (*websocket.SessionResponse, error) {
+	return c.session.Logout(requestID)
+}
+
+// SetSessionErrHandler sets the handler called when the session can not be logged on again after a reconnect
+func (c *WsApiClient) SetSessionErrHandler(errHandler ErrHandler) {
+	c.session.ErrHandler = errHandler
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (c *WsApiClient) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	c.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (c *WsApiClient) GetReadChannel() <-chan []byte {
+	return c.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (c *WsApiClient) GetReadErrorChannel() <-chan error {
+	return c.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (c *WsApiClient) GetReconnectCount() int64 {
+	return c.c.GetReconnectCount()
+}
+
+// SetLogger sets the logger of the connection, the api keys and signatures of the requests are redacted.
+// It is a no-op if the connection does not implement websocket.ConfigurableClient.
+func (c *WsApiClient) SetLogger(logger common.Logger) {
+	if cc, ok := c.c.(websocket.ConfigurableClient); ok {
+		cc.SetLogger(logger)
+	}
+}
+
+// SetUsedLimits sets the UsedLimits updated from the rateLimits of the responses,
+// e.g. the UsedLimits of the REST client to track the usage of both, see Client.NewWsApiClient.
+// It is a no-op if the connection does not implement websocket.ConfigurableClient.
+func (c *WsApiClient) SetUsedLimits(usedLimits *common.UsedLimits) {
+	if cc, ok := c.c.(websocket.ConfigurableClient); ok {
+		cc.SetUsedLimits(usedLimits)
+	}
+}
+
+// NewOrderPlaceWsService init OrderPlaceWsService using the client connection
+func (c *WsApiClient) NewOrderPlaceWsService() *OrderPlaceWsService {
+	return &OrderPlaceWsService{
+		c:          c.c,
+		session:    c.session,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderCancelWsService init OrderCancelWsService using the client connection
+func (c *WsApiClient) NewOrderCancelWsService() *OrderCancelWsService {
+	return &OrderCancelWsService{
+		c:          c.c,
+		session:    c.session,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		TimeOffset: c.TimeOffset,
+	}
+}
@@ -0,0 +1,53 @@
+package binance
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type interceptorTestSuite struct {
+	baseTestSuite
+}
+
+func TestInterceptor(t *testing.T) {
+	suite.Run(t, new(interceptorTestSuite))
+}
+
+func (s *interceptorTestSuite) TestCallAPIWithInterceptors() {
+	var calls []*common.Call
+	s.client.Interceptors = []common.Interceptor{func(ctx context.Context, call *common.Call, next common.Invoker) error {
+		err := next(ctx, call)
+		calls = append(calls, call)
+		return err
+	}}
+	header := http.Header{}
+	header.Set("X-Mbx-Used-Weight-1m", "20")
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(&http.Response{
+		Body:       io.NopCloser(bytes.NewBufferString(`{"code":-1013,"msg":"Filter failure: LOT_SIZE"}`)),
+		StatusCode: http.StatusBadRequest,
+		Header:     header,
+	}, nil).Once()
+
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("0.00000001").Do(newContext())
+	s.r().Error(err)
+	s.r().Len(calls, 1)
+	call := calls[0]
+	s.r().Equal(http.MethodPost, call.Method)
+	s.r().Equal("/api/v3/order", call.Endpoint)
+	s.r().Equal(int64(1), call.Weight)
+	s.r().Equal(int64(1), call.Orders)
+	s.r().Equal(http.StatusBadRequest, call.StatusCode)
+	s.r().Equal(common.ErrorCodeInvalidMessage, call.ErrorCode)
+	s.r().Equal(int64(20), call.UsedWeight["1M"])
+	s.r().NotContains(call.Query.Encode(), "signature")
+	s.r().Equal("BTCUSDT", call.Form.Get("symbol"))
+}
@@ -0,0 +1,69 @@
+package binance
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// testLogger records the logs as "LEVEL msg args"
+type testLogger struct {
+	logs []string
+}
+
+func (l *testLogger) log(level, msg string, args []interface{}) {
+	l.logs = append(l.logs, fmt.Sprintf("%s %s %v", level, msg, args))
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) { l.log("DEBUG", msg, args) }
+func (l *testLogger) Info(msg string, args ...interface{})  { l.log("INFO", msg, args) }
+func (l *testLogger) Warn(msg string, args ...interface{})  { l.log("WARN", msg, args) }
+func (l *testLogger) Error(msg string, args ...interface{}) { l.log("ERROR", msg, args) }
+
+type loggerTestSuite struct {
+	baseTestSuite
+}
+
+func TestLogger(t *testing.T) {
+	suite.Run(t, new(loggerTestSuite))
+}
+
+func (s *loggerTestSuite) TestSecretsAreRedacted() {
+	logger := new(testLogger)
+	s.client.StructuredLogger = logger
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(&http.Response{
+		Body:       io.NopCloser(bytes.NewBufferString(`{"listenKey":"pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1"}`)),
+		StatusCode: http.StatusOK,
+	}, nil).Once()
+
+	listenKey, err := s.client.NewStartUserStreamService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("pqia91ma19a5s61cv6a81va65sdf19v8a65a1a5s61cv6a81va65sdf19v8a65a1", listenKey)
+	s.r().Len(logger.logs, 2)
+	s.r().True(strings.HasPrefix(logger.logs[0], "DEBUG request [method POST url https://api.binance.com/api/v3/userDataStream"))
+	s.r().True(strings.HasPrefix(logger.logs[1], "DEBUG response [endpoint /api/v3/userDataStream status 200"))
+	for _, l := range logger.logs {
+		s.r().NotContains(l, s.client.APIKey)
+		s.r().NotContains(l, listenKey)
+	}
+}
+
+func (s *loggerTestSuite) TestSignatureIsRedacted() {
+	logger := new(testLogger)
+	s.client.StructuredLogger = logger
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(&http.Response{
+		Body:       io.NopCloser(bytes.NewBufferString(`[]`)),
+		StatusCode: http.StatusOK,
+	}, nil).Once()
+
+	_, err := s.client.NewListOpenOrdersService().Symbol("BTCUSDT").Do(newContext())
+	s.r().NoError(err)
+	s.r().Contains(logger.logs[0], "signature=REDACTED")
+}
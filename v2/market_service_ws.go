@@ -0,0 +1,394 @@
+package binance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// DepthWsService queries the order book
+type DepthWsService struct {
+	c *WsApiClient
+}
+
+// DepthWsRequest parameters for 'depth' websocket API
+type DepthWsRequest struct {
+	symbol string
+	limit  *int
+}
+
+// NewDepthWsRequest init DepthWsRequest
+func NewDepthWsRequest() *DepthWsRequest {
+	return &DepthWsRequest{}
+}
+
+// Symbol set symbol
+func (s *DepthWsRequest) Symbol(symbol string) *DepthWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// Limit set limit
+func (s *DepthWsRequest) Limit(limit int) *DepthWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// buildParams builds params
+func (s *DepthWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	return m
+}
+
+// Do - sends 'depth' request
+func (s *DepthWsService) Do(requestID string, request *DepthWsRequest) error {
+	return s.c.write(requestID, websocket.DepthSpotWsApiMethod, request.buildParams(), false)
+}
+
+// SyncDo - sends 'depth' request and receives response
+func (s *DepthWsService) SyncDo(requestID string, request *DepthWsRequest) (*DepthWsResponse, error) {
+	return s.syncDo(s.c.writeSync, requestID, request)
+}
+
+// SyncDoContext - sends 'depth' request and receives response until ctx is done
+func (s *DepthWsService) SyncDoContext(ctx context.Context, requestID string, request *DepthWsRequest) (*DepthWsResponse, error) {
+	return s.syncDo(s.c.writeSyncContext(ctx), requestID, request)
+}
+
+func (s *DepthWsService) syncDo(writeSync wsApiWriteSync, requestID string, request *DepthWsRequest) (*DepthWsResponse, error) {
+	raw := &wsApiResponse{}
+	if err := writeSync(requestID, websocket.DepthSpotWsApiMethod, request.buildParams(), false, raw); err != nil {
+		return nil, err
+	}
+	res := &DepthWsResponse{
+		Id:     raw.Id,
+		Status: raw.Status,
+		Error:  raw.Error,
+	}
+	if raw.Error != nil {
+		return res, nil
+	}
+	result, err := parseDepthResponse(raw.Result)
+	if err != nil {
+		return nil, err
+	}
+	res.Result = *result
+	return res, nil
+}
+
+// DepthWsResponse define 'depth' websocket API response
+type DepthWsResponse struct {
+	Id     string
+	Status int
+	Result DepthResponse
+
+	// error response
+	Error *common.APIError
+}
+
+// KlinesWsService queries klines
+type KlinesWsService struct {
+	c *WsApiClient
+}
+
+// KlinesWsRequest parameters for 'klines' websocket API
+type KlinesWsRequest struct {
+	symbol    string
+	interval  string
+	startTime *int64
+	endTime   *int64
+	timeZone  *string
+	limit     *int
+}
+
+// NewKlinesWsRequest init KlinesWsRequest
+func NewKlinesWsRequest() *KlinesWsRequest {
+	return &KlinesWsRequest{}
+}
+
+// Symbol set symbol
+func (s *KlinesWsRequest) Symbol(symbol string) *KlinesWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// Interval set interval
+func (s *KlinesWsRequest) Interval(interval string) *KlinesWsRequest {
+	s.interval = interval
+	return s
+}
+
+// StartTime set startTime
+func (s *KlinesWsRequest) StartTime(startTime int64) *KlinesWsRequest {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *KlinesWsRequest) EndTime(endTime int64) *KlinesWsRequest {
+	s.endTime = &endTime
+	return s
+}
+
+// TimeZone set timeZone
+func (s *KlinesWsRequest) TimeZone(timeZone string) *KlinesWsRequest {
+	s.timeZone = &timeZone
+	return s
+}
+
+// Limit set limit
+func (s *KlinesWsRequest) Limit(limit int) *KlinesWsRequest {
+	s.limit = &limit
+	return s
+}
+
+// buildParams builds params
+func (s *KlinesWsRequest) buildParams() params {
+	m := params{
+		"symbol":   s.symbol,
+		"interval": s.interval,
+	}
+	if s.startTime != nil {
+		m["startTime"] = *s.startTime
+	}
+	if s.endTime != nil {
+		m["endTime"] = *s.endTime
+	}
+	if s.timeZone != nil {
+		m["timeZone"] = *s.timeZone
+	}
+	if s.limit != nil {
+		m["limit"] = *s.limit
+	}
+	return m
+}
+
+// Do - sends 'klines' request
+func (s *KlinesWsService) Do(requestID string, request *KlinesWsRequest) error {
+	return s.c.write(requestID, websocket.KlinesSpotWsApiMethod, request.buildParams(), false)
+}
+
+// SyncDo - sends 'klines' request and receives response
+func (s *KlinesWsService) SyncDo(requestID string, request *KlinesWsRequest) (*KlinesWsResponse, error) {
+	return s.syncDo(s.c.writeSync, requestID, request)
+}
+
+// SyncDoContext - sends 'klines' request and receives response until ctx is done
+func (s *KlinesWsService) SyncDoContext(ctx context.Context, requestID string, request *KlinesWsRequest) (*KlinesWsResponse, error) {
+	return s.syncDo(s.c.writeSyncContext(ctx), requestID, request)
+}
+
+func (s *KlinesWsService) syncDo(writeSync wsApiWriteSync, requestID string, request *KlinesWsRequest) (*KlinesWsResponse, error) {
+	raw := &wsApiResponse{}
+	if err := writeSync(requestID, websocket.KlinesSpotWsApiMethod, request.buildParams(), false, raw); err != nil {
+		return nil, err
+	}
+	res := &KlinesWsResponse{
+		Id:     raw.Id,
+		Status: raw.Status,
+		Error:  raw.Error,
+	}
+	if raw.Error != nil {
+		return res, nil
+	}
+	result, err := parseKlines(raw.Result)
+	if err != nil {
+		return nil, err
+	}
+	res.Result = result
+	return res, nil
+}
+
+// KlinesWsResponse define 'klines' websocket API response
+type KlinesWsResponse struct {
+	Id     string
+	Status int
+	Result []*Kline
+
+	// error response
+	Error *common.APIError
+}
+
+// TickerPriceWsService queries the latest price of one or several symbols
+type TickerPriceWsService struct {
+	c *WsApiClient
+}
+
+// TickerPriceWsRequest parameters for 'ticker.price' websocket API
+type TickerPriceWsRequest struct {
+	symbol  *string
+	symbols []string
+}
+
+// NewTickerPriceWsRequest init TickerPriceWsRequest
+func NewTickerPriceWsRequest() *TickerPriceWsRequest {
+	return &TickerPriceWsRequest{}
+}
+
+// Symbol set symbol
+func (s *TickerPriceWsRequest) Symbol(symbol string) *TickerPriceWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// Symbols set symbols
+func (s *TickerPriceWsRequest) Symbols(symbols ...string) *TickerPriceWsRequest {
+	s.symbols = symbols
+	return s
+}
+
+// buildParams builds params
+func (s *TickerPriceWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	if len(s.symbols) > 0 {
+		m["symbols"] = s.symbols
+	}
+	return m
+}
+
+// Do - sends 'ticker.price' request
+func (s *TickerPriceWsService) Do(requestID string, request *TickerPriceWsRequest) error {
+	return s.c.write(requestID, websocket.TickerPriceSpotWsApiMethod, request.buildParams(), false)
+}
+
+// SyncDo - sends 'ticker.price' request and receives response
+func (s *TickerPriceWsService) SyncDo(requestID string, request *TickerPriceWsRequest) (*TickerPriceWsResponse, error) {
+	return s.syncDo(s.c.writeSync, requestID, request)
+}
+
+// SyncDoContext - sends 'ticker.price' request and receives response until ctx is done
+func (s *TickerPriceWsService) SyncDoContext(ctx context.Context, requestID string, request *TickerPriceWsRequest) (*TickerPriceWsResponse, error) {
+	return s.syncDo(s.c.writeSyncContext(ctx), requestID, request)
+}
+
+func (s *TickerPriceWsService) syncDo(writeSync wsApiWriteSync, requestID string, request *TickerPriceWsRequest) (*TickerPriceWsResponse, error) {
+	raw := &wsApiResponse{}
+	if err := writeSync(requestID, websocket.TickerPriceSpotWsApiMethod, request.buildParams(), false, raw); err != nil {
+		return nil, err
+	}
+	res := &TickerPriceWsResponse{
+		Id:     raw.Id,
+		Status: raw.Status,
+		Error:  raw.Error,
+	}
+	if raw.Error != nil {
+		return res, nil
+	}
+	// a single symbol returns an object, otherwise a list is returned
+	if bytes.HasPrefix(bytes.TrimSpace(raw.Result), []byte("{")) {
+		price := new(SymbolPrice)
+		if err := json.Unmarshal(raw.Result, price); err != nil {
+			return nil, err
+		}
+		res.Result = []*SymbolPrice{price}
+		return res, nil
+	}
+	if err := json.Unmarshal(raw.Result, &res.Result); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TickerPriceWsResponse define 'ticker.price' websocket API response
+type TickerPriceWsResponse struct {
+	Id     string
+	Status int
+	Result []*SymbolPrice
+
+	// error response
+	Error *common.APIError
+}
+
+// ExchangeInfoWsService queries exchange trading rules and symbol information
+type ExchangeInfoWsService struct {
+	c *WsApiClient
+}
+
+// ExchangeInfoWsRequest parameters for 'exchangeInfo' websocket API
+type ExchangeInfoWsRequest struct {
+	symbol      *string
+	symbols     []string
+	permissions []string
+}
+
+// NewExchangeInfoWsRequest init ExchangeInfoWsRequest
+func NewExchangeInfoWsRequest() *ExchangeInfoWsRequest {
+	return &ExchangeInfoWsRequest{}
+}
+
+// Symbol set symbol
+func (s *ExchangeInfoWsRequest) Symbol(symbol string) *ExchangeInfoWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// Symbols set symbols
+func (s *ExchangeInfoWsRequest) Symbols(symbols ...string) *ExchangeInfoWsRequest {
+	s.symbols = symbols
+	return s
+}
+
+// Permissions set permissions
+func (s *ExchangeInfoWsRequest) Permissions(permissions ...string) *ExchangeInfoWsRequest {
+	s.permissions = permissions
+	return s
+}
+
+// buildParams builds params
+func (s *ExchangeInfoWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	if len(s.symbols) > 0 {
+		m["symbols"] = s.symbols
+	}
+	if len(s.permissions) > 0 {
+		m["permissions"] = s.permissions
+	}
+	return m
+}
+
+// Do - sends 'exchangeInfo' request
+func (s *ExchangeInfoWsService) Do(requestID string, request *ExchangeInfoWsRequest) error {
+	return s.c.write(requestID, websocket.ExchangeInfoSpotWsApiMethod, request.buildParams(), false)
+}
+
+// SyncDo - sends 'exchangeInfo' request and receives response
+func (s *ExchangeInfoWsService) SyncDo(requestID string, request *ExchangeInfoWsRequest) (*ExchangeInfoWsResponse, error) {
+	return s.syncDo(s.c.writeSync, requestID, request)
+}
+
+// SyncDoContext - sends 'exchangeInfo' request and receives response until ctx is done
+func (s *ExchangeInfoWsService) SyncDoContext(ctx context.Context, requestID string, request *ExchangeInfoWsRequest) (*ExchangeInfoWsResponse, error) {
+	return s.syncDo(s.c.writeSyncContext(ctx), requestID, request)
+}
+
+func (s *ExchangeInfoWsService) syncDo(writeSync wsApiWriteSync, requestID string, request *ExchangeInfoWsRequest) (*ExchangeInfoWsResponse, error) {
+	res := &ExchangeInfoWsResponse{}
+	if err := writeSync(requestID, websocket.ExchangeInfoSpotWsApiMethod, request.buildParams(), false, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ExchangeInfoWsResponse define 'exchangeInfo' websocket API response
+type ExchangeInfoWsResponse struct {
+	Id     string       `json:"id"`
+	Status int          `json:"status"`
+	Result ExchangeInfo `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -0,0 +1,194 @@
+package binance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/stretchr/testify/suite"
+)
+
+type marketServiceWsTestSuite struct {
+	baseWsApiTestSuite
+}
+
+func TestMarketServiceWs(t *testing.T) {
+	suite.Run(t, new(marketServiceWsTestSuite))
+}
+
+func (s *marketServiceWsTestSuite) TestDepth() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 200,
+		"result": {
+			"lastUpdateId": 2731179239,
+			"bids": [["0.01379900", "3.43200000"], ["0.01379800", "3.24300000"]],
+			"asks": [["0.01380000", "5.91700000"]]
+		}
+	}`)
+
+	res, err := s.wsClient.NewDepthWsService().SyncDo(s.requestID, NewDepthWsRequest().Symbol("BNBBTC").Limit(5))
+	s.Require().NoError(err)
+	s.Equal(websocket.DepthSpotWsApiMethod, req.Method)
+	s.Equal("BNBBTC", req.Params["symbol"])
+	s.Equal(float64(5), req.Params["limit"])
+	s.assertUnsigned(req)
+
+	s.Equal(s.requestID, res.Id)
+	s.Equal(int64(2731179239), res.Result.LastUpdateID)
+	s.Equal([]Bid{
+		{Price: "0.01379900", Quantity: "3.43200000"},
+		{Price: "0.01379800", Quantity: "3.24300000"},
+	}, res.Result.Bids)
+	s.Equal([]Ask{{Price: "0.01380000", Quantity: "5.91700000"}}, res.Result.Asks)
+}
+
+func (s *marketServiceWsTestSuite) TestDepth_APIError() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 400,
+		"error": {"code": -1121, "msg": "Invalid symbol."}
+	}`)
+
+	res, err := s.wsClient.NewDepthWsService().SyncDo(s.requestID, NewDepthWsRequest().Symbol("UNKNOWN"))
+	s.Require().NoError(err)
+	s.Equal(400, res.Status)
+	s.Equal(int64(-1121), res.Error.Code)
+}
+
+func (s *marketServiceWsTestSuite) TestDepth_SyncDoContext() {
+	ctx := context.Background()
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSyncContext(ctx, req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 200,
+		"result": {
+			"lastUpdateId": 2731179239,
+			"bids": [["0.01379900", "3.43200000"]],
+			"asks": [["0.01380000", "5.91700000"]]
+		}
+	}`)
+
+	res, err := s.wsClient.NewDepthWsService().SyncDoContext(ctx, s.requestID, NewDepthWsRequest().Symbol("BNBBTC"))
+	s.Require().NoError(err)
+	s.Equal(websocket.DepthSpotWsApiMethod, req.Method)
+	s.Equal("BNBBTC", req.Params["symbol"])
+	s.Equal(int64(2731179239), res.Result.LastUpdateID)
+}
+
+func (s *marketServiceWsTestSuite) TestKlines() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 200,
+		"result": [
+			[
+				1655971200000,
+				"0.01086000",
+				"0.01086600",
+				"0.01083600",
+				"0.01083800",
+				"2290.53800000",
+				1655974799999,
+				"24.85074442",
+				2283,
+				"1171.64000000",
+				"12.71225884",
+				"0"
+			]
+		]
+	}`)
+
+	res, err := s.wsClient.NewKlinesWsService().SyncDo(s.requestID, NewKlinesWsRequest().
+		Symbol("BNBBTC").Interval("1h").StartTime(1655969280000).Limit(1))
+	s.Require().NoError(err)
+	s.Equal(websocket.KlinesSpotWsApiMethod, req.Method)
+	s.Equal("1h", req.Params["interval"])
+	s.Equal(float64(1655969280000), req.Params["startTime"])
+	s.assertUnsigned(req)
+
+	s.Require().Len(res.Result, 1)
+	s.Equal(&Kline{
+		OpenTime:                 1655971200000,
+		Open:                     "0.01086000",
+		High:                     "0.01086600",
+		Low:                      "0.01083600",
+		Close:                    "0.01083800",
+		Volume:                   "2290.53800000",
+		CloseTime:                1655974799999,
+		QuoteAssetVolume:         "24.85074442",
+		TradeNum:                 2283,
+		TakerBuyBaseAssetVolume:  "1171.64000000",
+		TakerBuyQuoteAssetVolume: "12.71225884",
+	}, res.Result[0])
+}
+
+func (s *marketServiceWsTestSuite) TestTickerPrice() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 200,
+		"result": {"symbol": "BNBBTC", "price": "0.01361900"}
+	}`)
+
+	res, err := s.wsClient.NewTickerPriceWsService().SyncDo(s.requestID, NewTickerPriceWsRequest().Symbol("BNBBTC"))
+	s.Require().NoError(err)
+	s.Equal(websocket.TickerPriceSpotWsApiMethod, req.Method)
+	s.Equal("BNBBTC", req.Params["symbol"])
+	s.assertUnsigned(req)
+
+	s.Equal([]*SymbolPrice{{Symbol: "BNBBTC", Price: "0.01361900"}}, res.Result)
+}
+
+func (s *marketServiceWsTestSuite) TestTickerPrice_Symbols() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 200,
+		"result": [
+			{"symbol": "BNBBTC", "price": "0.01361900"},
+			{"symbol": "BTCUSDT", "price": "24267.15000000"}
+		]
+	}`)
+
+	res, err := s.wsClient.NewTickerPriceWsService().SyncDo(s.requestID, NewTickerPriceWsRequest().
+		Symbols("BNBBTC", "BTCUSDT"))
+	s.Require().NoError(err)
+	s.Equal([]interface{}{"BNBBTC", "BTCUSDT"}, req.Params["symbols"])
+
+	s.Equal([]*SymbolPrice{
+		{Symbol: "BNBBTC", Price: "0.01361900"},
+		{Symbol: "BTCUSDT", Price: "24267.15000000"},
+	}, res.Result)
+}
+
+func (s *marketServiceWsTestSuite) TestExchangeInfo() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 200,
+		"result": {
+			"timezone": "UTC",
+			"serverTime": 1655969291181,
+			"rateLimits": [
+				{"rateLimitType": "REQUEST_WEIGHT", "interval": "MINUTE", "intervalNum": 1, "limit": 6000}
+			],
+			"exchangeFilters": [],
+			"symbols": [
+				{"symbol": "BNBBTC", "status": "TRADING", "baseAsset": "BNB", "quoteAsset": "BTC"}
+			]
+		}
+	}`)
+
+	res, err := s.wsClient.NewExchangeInfoWsService().SyncDo(s.requestID, NewExchangeInfoWsRequest().Symbol("BNBBTC"))
+	s.Require().NoError(err)
+	s.Equal(websocket.ExchangeInfoSpotWsApiMethod, req.Method)
+	s.assertUnsigned(req)
+
+	s.Equal("UTC", res.Result.Timezone)
+	s.Equal([]RateLimit{{RateLimitType: "REQUEST_WEIGHT", Interval: "MINUTE", IntervalNum: 1, Limit: 6000}}, res.Result.RateLimits)
+	s.Require().Len(res.Result.Symbols, 1)
+	s.Equal("BNBBTC", res.Result.Symbols[0].Symbol)
+}
@@ -0,0 +1,383 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// BlockTradeLiquidityType define the liquidity of a block trade order
+type BlockTradeLiquidityType string
+
+const (
+	BlockTradeLiquidityTypeTaker BlockTradeLiquidityType = "TAKER"
+	BlockTradeLiquidityTypeMaker BlockTradeLiquidityType = "MAKER"
+)
+
+// BlockTradeLeg define a leg of a block trade order
+type BlockTradeLeg struct {
+	Symbol   string   `json:"symbol"`
+	Side     SideType `json:"side"`
+	Price    string   `json:"price"`
+	Quantity string   `json:"quantity"`
+}
+
+// BlockTradeOrder define a block trade order, the counterparty accepts it with its
+// blockTradeSettlementKey before expireTime
+type BlockTradeOrder struct {
+	BlockTradeSettlementKey string                  `json:"blockTradeSettlementKey"`
+	ExpireTime              int64                   `json:"expireTime"`
+	Liquidity               BlockTradeLiquidityType `json:"liquidity"`
+	Status                  string                  `json:"status"`
+	CreateTime              int64                   `json:"createTime"`
+	UpdateTime              int64                   `json:"updateTime"`
+	Legs                    []*BlockTradeLeg        `json:"legs"`
+}
+
+// CreateBlockTradeOrderService create a block trade order
+type CreateBlockTradeOrderService struct {
+	c         *Client
+	liquidity BlockTradeLiquidityType
+	legs      []*BlockTradeLeg
+}
+
+// Liquidity set liquidity
+func (s *CreateBlockTradeOrderService) Liquidity(liquidity BlockTradeLiquidityType) *CreateBlockTradeOrderService {
+	s.liquidity = liquidity
+	return s
+}
+
+// Legs set legs
+func (s *CreateBlockTradeOrderService) Legs(legs ...*BlockTradeLeg) *CreateBlockTradeOrderService {
+	s.legs = legs
+	return s
+}
+
+// Do send request
+func (s *CreateBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) (res *BlockTradeOrder, err error) {
+	b, err := json.Marshal(s.legs)
+	if err != nil {
+		return nil, err
+	}
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/block/order/create",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"liquidity": s.liquidity,
+		"legs":      string(b),
+	})
+	return s.c.blockTradeOrder(ctx, r, opts...)
+}
+
+// ExtendBlockTradeOrderService extend the expire time of a block trade order by 30 minutes
+type ExtendBlockTradeOrderService struct {
+	c                     *Client
+	blockOrderMatchingKey string
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey, the blockTradeSettlementKey of the order
+func (s *ExtendBlockTradeOrderService) BlockOrderMatchingKey(blockOrderMatchingKey string) *ExtendBlockTradeOrderService {
+	s.blockOrderMatchingKey = blockOrderMatchingKey
+	return s
+}
+
+// Do send request
+func (s *ExtendBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) (res *BlockTradeOrder, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/eapi/v1/block/order/create",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("blockOrderMatchingKey", s.blockOrderMatchingKey)
+	return s.c.blockTradeOrder(ctx, r, opts...)
+}
+
+// CancelBlockTradeOrderService cancel a block trade order
+type CancelBlockTradeOrderService struct {
+	c                     *Client
+	blockOrderMatchingKey string
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey, the blockTradeSettlementKey of the order
+func (s *CancelBlockTradeOrderService) BlockOrderMatchingKey(blockOrderMatchingKey string) *CancelBlockTradeOrderService {
+	s.blockOrderMatchingKey = blockOrderMatchingKey
+	return s
+}
+
+// Do send request
+func (s *CancelBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) error {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: "/eapi/v1/block/order/create",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("blockOrderMatchingKey", s.blockOrderMatchingKey)
+	_, _, err := s.c.callAPI(ctx, r, opts...)
+	return err
+}
+
+// ListBlockTradeOrdersService list the block trade orders
+type ListBlockTradeOrdersService struct {
+	c                     *Client
+	blockOrderMatchingKey *string
+	underlying            *string
+	startTime             *int64
+	endTime               *int64
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey, the blockTradeSettlementKey of the order
+func (s *ListBlockTradeOrdersService) BlockOrderMatchingKey(blockOrderMatchingKey string) *ListBlockTradeOrdersService {
+	s.blockOrderMatchingKey = &blockOrderMatchingKey
+	return s
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *ListBlockTradeOrdersService) Underlying(underlying string) *ListBlockTradeOrdersService {
+	s.underlying = &underlying
+	return s
+}
+
+// StartTime set startTime
+func (s *ListBlockTradeOrdersService) StartTime(startTime int64) *ListBlockTradeOrdersService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListBlockTradeOrdersService) EndTime(endTime int64) *ListBlockTradeOrdersService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *ListBlockTradeOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*BlockTradeOrder, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/block/order/orders",
+		secType:  secTypeSigned,
+	}
+	if s.blockOrderMatchingKey != nil {
+		r.setParam("blockOrderMatchingKey", *s.blockOrderMatchingKey)
+	}
+	if s.underlying != nil {
+		r.setParam("underlying", *s.underlying)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*BlockTradeOrder, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AcceptBlockTradeOrderService accept a block trade order of a counterparty
+type AcceptBlockTradeOrderService struct {
+	c                     *Client
+	blockOrderMatchingKey string
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey, the blockTradeSettlementKey of the order
+func (s *AcceptBlockTradeOrderService) BlockOrderMatchingKey(blockOrderMatchingKey string) *AcceptBlockTradeOrderService {
+	s.blockOrderMatchingKey = blockOrderMatchingKey
+	return s
+}
+
+// Do send request
+func (s *AcceptBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) (res *BlockTradeOrder, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/block/order/execute",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("blockOrderMatchingKey", s.blockOrderMatchingKey)
+	return s.c.blockTradeOrder(ctx, r, opts...)
+}
+
+// GetBlockTradeOrderService get a block trade order before accepting it
+type GetBlockTradeOrderService struct {
+	c                     *Client
+	blockOrderMatchingKey string
+}
+
+// BlockOrderMatchingKey set blockOrderMatchingKey, the blockTradeSettlementKey of the order
+func (s *GetBlockTradeOrderService) BlockOrderMatchingKey(blockOrderMatchingKey string) *GetBlockTradeOrderService {
+	s.blockOrderMatchingKey = blockOrderMatchingKey
+	return s
+}
+
+// Do send request
+func (s *GetBlockTradeOrderService) Do(ctx context.Context, opts ...RequestOption) (res *BlockTradeOrder, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/block/order/execute",
+		secType:  secTypeSigned,
+	}
+	r.setParam("blockOrderMatchingKey", s.blockOrderMatchingKey)
+	return s.c.blockTradeOrder(ctx, r, opts...)
+}
+
+func (c *Client) blockTradeOrder(ctx context.Context, r *request, opts ...RequestOption) (res *BlockTradeOrder, err error) {
+	data, _, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(BlockTradeOrder)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListBlockUserTradesService list the executed block trades of the account
+type ListBlockUserTradesService struct {
+	c          *Client
+	underlying *string
+	startTime  *int64
+	endTime    *int64
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *ListBlockUserTradesService) Underlying(underlying string) *ListBlockUserTradesService {
+	s.underlying = &underlying
+	return s
+}
+
+// StartTime set startTime
+func (s *ListBlockUserTradesService) StartTime(startTime int64) *ListBlockUserTradesService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListBlockUserTradesService) EndTime(endTime int64) *ListBlockUserTradesService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *ListBlockUserTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*BlockUserTrade, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/block/user-trades",
+		secType:  secTypeSigned,
+	}
+	if s.underlying != nil {
+		r.setParam("underlying", *s.underlying)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*BlockUserTrade, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// BlockUserTrade define an executed block trade of the account
+type BlockUserTrade struct {
+	ParentOrderId           string               `json:"parentOrderId"`
+	CrossType               string               `json:"crossType"`
+	BlockTradeSettlementKey string               `json:"blockTradeSettlementKey"`
+	Legs                    []*BlockUserTradeLeg `json:"legs"`
+}
+
+// BlockUserTradeLeg define the order and trade of a leg of an executed block trade
+type BlockUserTradeLeg struct {
+	CreateTime     int64                   `json:"createTime"`
+	UpdateTime     int64                   `json:"updateTime"`
+	Symbol         string                  `json:"symbol"`
+	OrderId        string                  `json:"orderId"`
+	OrderPrice     string                  `json:"orderPrice"`
+	OrderQuantity  string                  `json:"orderQuantity"`
+	OrderStatus    OrderStatusType         `json:"orderStatus"`
+	ExecutedQty    string                  `json:"executedQty"`
+	ExecutedAmount string                  `json:"executedAmount"`
+	Fee            string                  `json:"fee"`
+	OrderType      OrderType               `json:"orderType"`
+	OrderSide      SideType                `json:"orderSide"`
+	Id             string                  `json:"id"`
+	TradeId        int64                   `json:"tradeId"`
+	TradePrice     string                  `json:"tradePrice"`
+	TradeQty       string                  `json:"tradeQty"`
+	TradeTime      int64                   `json:"tradeTime"`
+	Liquidity      BlockTradeLiquidityType `json:"liquidity"`
+	Commission     string                  `json:"commission"`
+}
+
+// RecentBlockTradesService list the recent block trades of the market
+type RecentBlockTradesService struct {
+	c      *Client
+	symbol *string
+	limit  *int
+}
+
+// Symbol set symbol
+func (s *RecentBlockTradesService) Symbol(symbol string) *RecentBlockTradesService {
+	s.symbol = &symbol
+	return s
+}
+
+// Limit set limit, 100 by default, up to 500
+func (s *RecentBlockTradesService) Limit(limit int) *RecentBlockTradesService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *RecentBlockTradesService) Do(ctx context.Context, opts ...RequestOption) (res []*BlockTrade, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/blockTrades",
+		secType:  secTypeNone,
+	}
+	if s.symbol != nil {
+		r.setParam("symbol", *s.symbol)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]*BlockTrade, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// BlockTrade define a block trade of the market
+type BlockTrade struct {
+	Id       int64  `json:"id"`
+	TradeId  int64  `json:"tradeId"`
+	Symbol   string `json:"symbol"`
+	Price    string `json:"price"`
+	Quantity string `json:"qty"`
+	QuoteQty string `json:"quoteQty"`
+	Side     int    `json:"side"`
+	Time     int64  `json:"time"`
+}
@@ -0,0 +1,257 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type blockTradeServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestBlockTradeService(t *testing.T) {
+	suite.Run(t, new(blockTradeServiceTestSuite))
+}
+
+var blockTradeOrderData = []byte(`{
+	"blockTradeSettlementKey": "3668822b8-1baa-4a17-9d87-c6a2c4cc4a5f",
+	"expireTime": 1730171888109,
+	"liquidity": "TAKER",
+	"status": "RECEIVED",
+	"createTime": 1730170088111,
+	"updateTime": 1730170088111,
+	"legs": [
+		{
+			"symbol": "BNB-241101-700-C",
+			"side": "BUY",
+			"quantity": "1.2",
+			"price": "2.8"
+		}
+	]
+}`)
+
+func (s *blockTradeServiceTestSuite) assertBlockTradeOrder(a *BlockTradeOrder) {
+	s.r().Equal(&BlockTradeOrder{
+		BlockTradeSettlementKey: "3668822b8-1baa-4a17-9d87-c6a2c4cc4a5f",
+		ExpireTime:              1730171888109,
+		Liquidity:               BlockTradeLiquidityTypeTaker,
+		Status:                  "RECEIVED",
+		CreateTime:              1730170088111,
+		UpdateTime:              1730170088111,
+		Legs: []*BlockTradeLeg{
+			{
+				Symbol:   "BNB-241101-700-C",
+				Side:     SideTypeBuy,
+				Quantity: "1.2",
+				Price:    "2.8",
+			},
+		},
+	}, a)
+}
+
+func (s *blockTradeServiceTestSuite) TestCreateBlockTradeOrder() {
+	s.mockDo(blockTradeOrderData, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"liquidity": BlockTradeLiquidityTypeTaker,
+			"legs":      `[{"symbol":"BNB-241101-700-C","side":"BUY","price":"2.8","quantity":"1.2"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateBlockTradeOrderService().Liquidity(BlockTradeLiquidityTypeTaker).
+		Legs(&BlockTradeLeg{Symbol: "BNB-241101-700-C", Side: SideTypeBuy, Price: "2.8", Quantity: "1.2"}).
+		Do(newContext())
+	s.r().NoError(err)
+	s.assertBlockTradeOrder(res)
+}
+
+func (s *blockTradeServiceTestSuite) TestExtendBlockTradeOrder() {
+	s.mockDo(blockTradeOrderData, nil)
+	defer s.assertDo()
+
+	key := "3668822b8-1baa-4a17-9d87-c6a2c4cc4a5f"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParam("blockOrderMatchingKey", key)
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewExtendBlockTradeOrderService().BlockOrderMatchingKey(key).Do(newContext())
+	s.r().NoError(err)
+	s.assertBlockTradeOrder(res)
+}
+
+func (s *blockTradeServiceTestSuite) TestCancelBlockTradeOrder() {
+	s.mockDo([]byte(`{}`), nil)
+	defer s.assertDo()
+
+	key := "3668822b8-1baa-4a17-9d87-c6a2c4cc4a5f"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParam("blockOrderMatchingKey", key)
+		s.assertRequestEqual(e, r)
+	})
+	err := s.client.NewCancelBlockTradeOrderService().BlockOrderMatchingKey(key).Do(newContext())
+	s.r().NoError(err)
+}
+
+func (s *blockTradeServiceTestSuite) TestListBlockTradeOrders() {
+	s.mockDo([]byte(`[`+string(blockTradeOrderData)+`]`), nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"underlying": "BNBUSDT",
+			"startTime":  1730170000000,
+			"endTime":    1730180000000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewListBlockTradeOrdersService().Underlying("BNBUSDT").
+		StartTime(1730170000000).EndTime(1730180000000).Do(newContext())
+	s.r().NoError(err)
+	s.r().Len(res, 1)
+	s.assertBlockTradeOrder(res[0])
+}
+
+func (s *blockTradeServiceTestSuite) TestAcceptBlockTradeOrder() {
+	s.mockDo(blockTradeOrderData, nil)
+	defer s.assertDo()
+
+	key := "3668822b8-1baa-4a17-9d87-c6a2c4cc4a5f"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParam("blockOrderMatchingKey", key)
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewAcceptBlockTradeOrderService().BlockOrderMatchingKey(key).Do(newContext())
+	s.r().NoError(err)
+	s.assertBlockTradeOrder(res)
+}
+
+func (s *blockTradeServiceTestSuite) TestGetBlockTradeOrder() {
+	s.mockDo(blockTradeOrderData, nil)
+	defer s.assertDo()
+
+	key := "3668822b8-1baa-4a17-9d87-c6a2c4cc4a5f"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("blockOrderMatchingKey", key)
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetBlockTradeOrderService().BlockOrderMatchingKey(key).Do(newContext())
+	s.r().NoError(err)
+	s.assertBlockTradeOrder(res)
+}
+
+func (s *blockTradeServiceTestSuite) TestListBlockUserTrades() {
+	data := []byte(`[
+		{
+			"parentOrderId": "4675011431944499201",
+			"crossType": "USER_BLOCK",
+			"legs": [
+				{
+					"createTime": 1730170445600,
+					"updateTime": 1730170445600,
+					"symbol": "BNB-241101-700-C",
+					"orderId": "4675011431944499203",
+					"orderPrice": "2.8",
+					"orderQuantity": "1.2",
+					"orderStatus": "FILLED",
+					"executedQty": "1.2",
+					"executedAmount": "3.36",
+					"fee": "0.336",
+					"orderType": "LIMIT",
+					"orderSide": "BUY",
+					"id": "1125899906900937837",
+					"tradeId": 1,
+					"tradePrice": "2.8",
+					"tradeQty": "1.2",
+					"tradeTime": 1730170445600,
+					"liquidity": "TAKER",
+					"commission": "0.336"
+				}
+			],
+			"blockTradeSettlementKey": "7d046e6e-a429-4335-ab9d-6a681febcde5"
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("underlying", "BNBUSDT")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewListBlockUserTradesService().Underlying("BNBUSDT").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*BlockUserTrade{
+		{
+			ParentOrderId:           "4675011431944499201",
+			CrossType:               "USER_BLOCK",
+			BlockTradeSettlementKey: "7d046e6e-a429-4335-ab9d-6a681febcde5",
+			Legs: []*BlockUserTradeLeg{
+				{
+					CreateTime:     1730170445600,
+					UpdateTime:     1730170445600,
+					Symbol:         "BNB-241101-700-C",
+					OrderId:        "4675011431944499203",
+					OrderPrice:     "2.8",
+					OrderQuantity:  "1.2",
+					OrderStatus:    OrderStatusTypeFilled,
+					ExecutedQty:    "1.2",
+					ExecutedAmount: "3.36",
+					Fee:            "0.336",
+					OrderType:      OrderTypeLimit,
+					OrderSide:      SideTypeBuy,
+					Id:             "1125899906900937837",
+					TradeId:        1,
+					TradePrice:     "2.8",
+					TradeQty:       "1.2",
+					TradeTime:      1730170445600,
+					Liquidity:      BlockTradeLiquidityTypeTaker,
+					Commission:     "0.336",
+				},
+			},
+		},
+	}, res)
+}
+
+func (s *blockTradeServiceTestSuite) TestRecentBlockTrades() {
+	data := []byte(`[
+		{
+			"id": 1125899906901081078,
+			"tradeId": 389,
+			"symbol": "ETH-232192-3500-C",
+			"price": "100.0",
+			"qty": "0.01",
+			"quoteQty": "1.0",
+			"side": 1,
+			"time": 1730171880000
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol": "ETH-232192-3500-C",
+			"limit":  10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewRecentBlockTradesService().Symbol("ETH-232192-3500-C").
+		Limit(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*BlockTrade{
+		{
+			Id:       1125899906901081078,
+			TradeId:  389,
+			Symbol:   "ETH-232192-3500-C",
+			Price:    "100.0",
+			Quantity: "0.01",
+			QuoteQty: "1.0",
+			Side:     1,
+			Time:     1730171880000,
+		},
+	}, res)
+}
@@ -211,6 +211,7 @@ func NewClient(apiKey, secretKey string) *Client {
 		UserAgent:  "Binance/golang",
 		HTTPClient: http.DefaultClient,
 		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		UsedLimits: common.NewUsedLimits(),
 	}
 }
 
@@ -233,7 +234,8 @@ func NewProxiedClient(apiKey, secretKey, proxyUrl string) *Client {
 		HTTPClient: &http.Client{
 			Transport: tr,
 		},
-		Logger: log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		Logger:     log.New(os.Stderr, "Binance-golang ", log.LstdFlags),
+		UsedLimits: common.NewUsedLimits(),
 	}
 }
 
@@ -251,12 +253,39 @@ type Client struct {
 	Logger     *log.Logger
 	TimeOffset int64
 	do         doFunc
-}
 
-func (c *Client) debug(format string, v ...interface{}) {
+	// RateLimiter is an optional client side rate limiter, see NewRateLimiter
+	RateLimiter *common.RateLimiter
+	// RetryPolicy enables retries of failed requests, see common.NewRetryPolicy
+	RetryPolicy *common.RetryPolicy
+	// ClockSync keeps the timestamp of signed requests in sync with the server, see NewClockSync
+	ClockSync *common.ClockSync
+	// Interceptors are called in order around each request, see common.Interceptor
+	Interceptors []common.Interceptor
+	// StructuredLogger receives the logs of the client, the secrets are redacted.
+	// Logger is used at debug level when it is nil and Debug is set.
+	StructuredLogger common.Logger
+	// UsedLimits keeps the used weight and order count reported by the server, see common.UsedLimits
+	UsedLimits *common.UsedLimits
+}
+
+// logger return StructuredLogger, or Logger at debug level if Debug is set
+func (c *Client) logger() common.Logger {
+	if c.StructuredLogger != nil {
+		return c.StructuredLogger
+	}
 	if c.Debug {
-		c.Logger.Printf(format, v...)
+		return common.NewStdLogger(c.Logger, common.LogLevelDebug)
+	}
+	return common.NopLogger
+}
+
+// timeOffset return the offset of ClockSync once synced, TimeOffset otherwise
+func (c *Client) timeOffset() int64 {
+	if offset, ok := c.ClockSync.Offset(); ok {
+		return offset
 	}
+	return c.TimeOffset
 }
 
 func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
@@ -274,7 +303,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.timeOffset())
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -315,7 +344,8 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	if queryString != "" {
 		fullURL = fmt.Sprintf("%s?%s", fullURL, queryString)
 	}
-	c.debug("full url: %s, body: %s", fullURL, bodyString)
+	c.logger().Debug("request", "method", r.method, "url", common.RedactURL(fullURL),
+		"body", common.RedactQuery(bodyString), "header", common.RedactHeader(header))
 
 	r.fullURL = fullURL
 	r.header = header
@@ -324,28 +354,74 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 }
 
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	resynced := false
+	for attempt := 0; ; attempt++ {
+		var res *http.Response
+		data, res, err = c.callAPIOnce(ctx, r, attempt, opts...)
+		// re-issue a request rejected for its timestamp once, after resyncing the clock
+		if !resynced && r.secType == secTypeSigned && c.ClockSync.Resync(ctx, err) {
+			resynced = true
+			opts = nil
+			continue
+		}
+		delay, retry := c.RetryPolicy.Retry(attempt, r.method, res, err)
+		if !retry {
+			header = &http.Header{}
+			if res != nil {
+				header = &res.Header
+			}
+			return data, header, err
+		}
+		c.logger().Warn("retry request", "endpoint", r.endpoint, "attempt", attempt, "delay", delay, "error", err)
+		if err = common.Sleep(ctx, delay); err != nil {
+			return []byte{}, &http.Header{}, err
+		}
+		// request options have been applied by the first attempt
+		opts = nil
+	}
+}
+
+func (c *Client) callAPIOnce(ctx context.Context, r *request, attempt int, opts ...RequestOption) (data []byte, res *http.Response, err error) {
 	err = c.parseRequest(r, opts...)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, nil, err
 	}
 	req, err := http.NewRequest(r.method, r.fullURL, r.body)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, nil, err
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
-	c.debug("request: %#v\n", req)
+	weight, orders := requestCost(r)
+	if c.RateLimiter != nil {
+		if err = c.RateLimiter.Wait(ctx, weight, orders); err != nil {
+			return []byte{}, nil, err
+		}
+	}
+	call := common.NewCall(r.method, r.endpoint, r.query, r.form, weight, orders, attempt)
+	err = common.Invoke(ctx, c.Interceptors, call, func(ctx context.Context) (*http.Response, error) {
+		var sendErr error
+		data, res, sendErr = c.send(req.WithContext(ctx))
+		return res, sendErr
+	})
+	return data, res, err
+}
+
+// send sends the request and reads the response, an API error is returned for 4xx and 5xx responses
+func (c *Client) send(req *http.Request) (data []byte, res *http.Response, err error) {
 	f := c.do
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
-	res, err := f(req)
+	res, err = f(req)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, nil, err
 	}
+	c.RateLimiter.Update(res.Header)
+	c.UsedLimits.Update(res.Header)
 	data, err = io.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, res, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -355,22 +431,20 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 			err = cerr
 		}
 	}()
-	c.debug("response: %#v\n", res)
-	c.debug("response body: %s\n", string(data))
-	c.debug("response status code: %d\n", res.StatusCode)
+	c.logger().Debug("response", "endpoint", req.URL.Path, "status", res.StatusCode, "body", common.RedactedJSON(data))
 
 	if res.StatusCode >= http.StatusBadRequest {
 		apiErr := new(common.APIError)
 		e := json.Unmarshal(data, apiErr)
 		if e != nil {
-			c.debug("failed to unmarshal json: %s\n", e)
+			c.logger().Warn("failed to unmarshal api error", "error", e)
 		}
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, &res.Header, apiErr
+		return nil, res, apiErr
 	}
-	return data, &res.Header, nil
+	return data, res, nil
 }
 
 // SetApiEndpoint set api Endpoint
@@ -389,6 +463,14 @@ func (c *Client) NewServerTimeService() *ServerTimeService {
 	return &ServerTimeService{c: c}
 }
 
+// NewClockSync init a ClockSync polling the server time, assign it to Client.ClockSync
+// and call Start to keep the timestamp of signed requests in sync in the background
+func (c *Client) NewClockSync() *common.ClockSync {
+	return common.NewClockSync(func(ctx context.Context) (int64, error) {
+		return c.NewServerTimeService().Do(ctx)
+	})
+}
+
 // NewExchangeInfoService init exchange info service
 func (c *Client) NewExchangeInfoService() *ExchangeInfoService {
 	return &ExchangeInfoService{c: c}
@@ -436,6 +518,12 @@ func (c *Client) NewAccountService() *AccountService {
 	return &AccountService{c: c}
 }
 
+// NewMarginAccountService init margin account service
+// GET /eapi/v1/marginAccount
+func (c *Client) NewMarginAccountService() *MarginAccountService {
+	return &MarginAccountService{c: c}
+}
+
 // NewCreateOrderService init creating order service
 // POST /eapi/v1/order
 func (c *Client) NewCreateOrderService() *CreateOrderService {
@@ -477,6 +565,24 @@ func (c *Client) NewCancelAllOpenOrdersByUnderlyingService() *CancelAllOpenOrder
 	return &CancelAllOpenOrdersByUnderlyingService{c: c}
 }
 
+// NewCountdownCancelAllService init countdown cancel all service
+// POST /eapi/v1/countdownCancelAll
+func (c *Client) NewCountdownCancelAllService() *CountdownCancelAllService {
+	return &CountdownCancelAllService{c: c}
+}
+
+// NewGetCountdownCancelAllService init get countdown cancel all service
+// GET /eapi/v1/countdownCancelAll
+func (c *Client) NewGetCountdownCancelAllService() *GetCountdownCancelAllService {
+	return &GetCountdownCancelAllService{c: c}
+}
+
+// NewCountdownCancelAllHeartbeatService init countdown cancel all heartbeat service
+// POST /eapi/v1/countdownCancelAllHeartBeat
+func (c *Client) NewCountdownCancelAllHeartbeatService() *CountdownCancelAllHeartbeatService {
+	return &CountdownCancelAllHeartbeatService{c: c}
+}
+
 // NewListOpenOrdersService init list open orders service
 // GET /eapi/v1/openOrders
 func (c *Client) NewListOpenOrdersService() *ListOpenOrdersService {
@@ -532,3 +638,69 @@ func (c *Client) NewKeepaliveUserStreamService() *KeepaliveUserStreamService {
 func (c *Client) NewCloseUserStreamService() *CloseUserStreamService {
 	return &CloseUserStreamService{c: c}
 }
+
+// NewSetMmpService init set market maker protection config service
+// POST /eapi/v1/mmpSet
+func (c *Client) NewSetMmpService() *SetMmpService {
+	return &SetMmpService{c: c}
+}
+
+// NewResetMmpService init reset market maker protection service
+// POST /eapi/v1/mmpReset
+func (c *Client) NewResetMmpService() *ResetMmpService {
+	return &ResetMmpService{c: c}
+}
+
+// NewGetMmpService init get market maker protection config service
+// GET /eapi/v1/mmp
+func (c *Client) NewGetMmpService() *GetMmpService {
+	return &GetMmpService{c: c}
+}
+
+// NewCreateBlockTradeOrderService init create block trade order service
+// POST /eapi/v1/block/order/create
+func (c *Client) NewCreateBlockTradeOrderService() *CreateBlockTradeOrderService {
+	return &CreateBlockTradeOrderService{c: c}
+}
+
+// NewExtendBlockTradeOrderService init extend block trade order service
+// PUT /eapi/v1/block/order/create
+func (c *Client) NewExtendBlockTradeOrderService() *ExtendBlockTradeOrderService {
+	return &ExtendBlockTradeOrderService{c: c}
+}
+
+// NewCancelBlockTradeOrderService init cancel block trade order service
+// DELETE /eapi/v1/block/order/create
+func (c *Client) NewCancelBlockTradeOrderService() *CancelBlockTradeOrderService {
+	return &CancelBlockTradeOrderService{c: c}
+}
+
+// NewListBlockTradeOrdersService init list block trade orders service
+// GET /eapi/v1/block/order/orders
+func (c *Client) NewListBlockTradeOrdersService() *ListBlockTradeOrdersService {
+	return &ListBlockTradeOrdersService{c: c}
+}
+
+// NewAcceptBlockTradeOrderService init accept block trade order service
+// POST /eapi/v1/block/order/execute
+func (c *Client) NewAcceptBlockTradeOrderService() *AcceptBlockTradeOrderService {
+	return &AcceptBlockTradeOrderService{c: c}
+}
+
+// NewGetBlockTradeOrderService init get block trade order service
+// GET /eapi/v1/block/order/execute
+func (c *Client) NewGetBlockTradeOrderService() *GetBlockTradeOrderService {
+	return &GetBlockTradeOrderService{c: c}
+}
+
+// NewListBlockUserTradesService init list account block trades service
+// GET /eapi/v1/block/user-trades
+func (c *Client) NewListBlockUserTradesService() *ListBlockUserTradesService {
+	return &ListBlockUserTradesService{c: c}
+}
+
+// NewRecentBlockTradesService init recent block trades service
+// GET /eapi/v1/blockTrades
+func (c *Client) NewRecentBlockTradesService() *RecentBlockTradesService {
+	return &RecentBlockTradesService{c: c}
+}
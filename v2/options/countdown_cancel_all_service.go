@@ -0,0 +1,150 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// MinCountdown is the shortest countdown of the countdown cancel-all
+const MinCountdown = 5 * time.Second
+
+// CountdownCancelAllService set the countdown of the auto-cancel of all open orders of an underlying,
+// the countdown is reset by CountdownCancelAllHeartbeatService and a zero countdown disables it
+type CountdownCancelAllService struct {
+	c             *Client
+	underlying    string
+	countdownTime int64
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *CountdownCancelAllService) Underlying(underlying string) *CountdownCancelAllService {
+	s.underlying = underlying
+	return s
+}
+
+// CountdownTime set countdownTime in milliseconds, at least 5000, 0 disables the countdown
+func (s *CountdownCancelAllService) CountdownTime(countdownTime int64) *CountdownCancelAllService {
+	s.countdownTime = countdownTime
+	return s
+}
+
+// Do send request
+func (s *CountdownCancelAllService) Do(ctx context.Context, opts ...RequestOption) (res *CountdownCancelAll, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/countdownCancelAll",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"underlying":    s.underlying,
+		"countdownTime": s.countdownTime,
+	})
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CountdownCancelAll)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountdownCancelAll define the countdown of an underlying
+type CountdownCancelAll struct {
+	Underlying    string `json:"underlying"`
+	CountdownTime int64  `json:"countdownTime"`
+}
+
+// GetCountdownCancelAllService get the countdown of the auto-cancel of all open orders
+type GetCountdownCancelAllService struct {
+	c          *Client
+	underlying *string
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *GetCountdownCancelAllService) Underlying(underlying string) *GetCountdownCancelAllService {
+	s.underlying = &underlying
+	return s
+}
+
+// Do send request
+func (s *GetCountdownCancelAllService) Do(ctx context.Context, opts ...RequestOption) (res *CountdownCancelAll, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/countdownCancelAll",
+		secType:  secTypeSigned,
+	}
+	if s.underlying != nil {
+		r.setParam("underlying", *s.underlying)
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CountdownCancelAll)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountdownCancelAllHeartbeatService reset the countdown of the auto-cancel of all open orders of the underlyings
+type CountdownCancelAllHeartbeatService struct {
+	c           *Client
+	underlyings []string
+}
+
+// Underlyings set underlyings, e.g. BTCUSDT
+func (s *CountdownCancelAllHeartbeatService) Underlyings(underlyings ...string) *CountdownCancelAllHeartbeatService {
+	s.underlyings = underlyings
+	return s
+}
+
+// Do send request
+func (s *CountdownCancelAllHeartbeatService) Do(ctx context.Context, opts ...RequestOption) (res *CountdownCancelAllHeartbeat, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/countdownCancelAllHeartBeat",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("underlyings", strings.Join(s.underlyings, ","))
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CountdownCancelAllHeartbeat)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CountdownCancelAllHeartbeat define the underlyings whose countdown has been reset
+type CountdownCancelAllHeartbeat struct {
+	Underlyings []string `json:"underlyings"`
+}
+
+// NewCountdownHeartbeat init a heartbeat keeping the countdown cancel-all of underlying armed, call Start to set
+// the countdown and reset it in the background. The open orders of underlying are cancelled by the exchange
+// if no heartbeat is received for countdown, which must be at least MinCountdown.
+func (c *Client) NewCountdownHeartbeat(underlying string, countdown time.Duration) *common.CountdownHeartbeat {
+	h := common.NewCountdownHeartbeat(func(ctx context.Context, countdown time.Duration) error {
+		_, err := c.NewCountdownCancelAllService().Underlying(underlying).
+			CountdownTime(countdown.Milliseconds()).Do(ctx)
+		return err
+	}, func(ctx context.Context) error {
+		_, err := c.NewCountdownCancelAllHeartbeatService().Underlyings(underlying).Do(ctx)
+		return err
+	}, countdown)
+	h.MinCountdown = MinCountdown
+	return h
+}
@@ -0,0 +1,109 @@
+package options
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type countdownCancelAllServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestCountdownCancelAllService(t *testing.T) {
+	suite.Run(t, new(countdownCancelAllServiceTestSuite))
+}
+
+func (s *countdownCancelAllServiceTestSuite) TestCountdownCancelAll() {
+	data := []byte(`{
+		"underlying": "ETHUSDT",
+		"countdownTime": 30000
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	underlying := "ETHUSDT"
+	countdownTime := int64(30000)
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"underlying":    underlying,
+			"countdownTime": countdownTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCountdownCancelAllService().Underlying(underlying).
+		CountdownTime(countdownTime).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&CountdownCancelAll{
+		Underlying:    underlying,
+		CountdownTime: countdownTime,
+	}, res)
+}
+
+func (s *countdownCancelAllServiceTestSuite) TestGetCountdownCancelAll() {
+	data := []byte(`{
+		"underlying": "ETHUSDT",
+		"countdownTime": 100000
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	underlying := "ETHUSDT"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("underlying", underlying)
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetCountdownCancelAllService().Underlying(underlying).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&CountdownCancelAll{
+		Underlying:    underlying,
+		CountdownTime: 100000,
+	}, res)
+}
+
+func (s *countdownCancelAllServiceTestSuite) TestCountdownCancelAllHeartbeat() {
+	data := []byte(`{
+		"underlyings": ["BTCUSDT", "ETHUSDT"]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParam("underlyings", "BTCUSDT,ETHUSDT")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCountdownCancelAllHeartbeatService().
+		Underlyings("BTCUSDT", "ETHUSDT").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]string{"BTCUSDT", "ETHUSDT"}, res.Underlyings)
+}
+
+func (s *countdownCancelAllServiceTestSuite) TestCountdownHeartbeat() {
+	data := []byte(`{
+		"underlying": "ETHUSDT",
+		"countdownTime": 30000
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"underlying":    "ETHUSDT",
+			"countdownTime": 30000,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	h := s.client.NewCountdownHeartbeat("ETHUSDT", 30*time.Second)
+	s.r().NoError(h.Start(newContext()))
+	h.Stop()
+}
+
+func (s *countdownCancelAllServiceTestSuite) TestCountdownHeartbeatMinCountdown() {
+	h := s.client.NewCountdownHeartbeat("ETHUSDT", 3*time.Second)
+	s.r().Equal(common.ErrorCountdownTooShort, h.Start(newContext()))
+}
@@ -0,0 +1,51 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// MarginAccountService get the margin account of the options account
+type MarginAccountService struct {
+	c *Client
+}
+
+// Do send request
+func (s *MarginAccountService) Do(ctx context.Context, opts ...RequestOption) (res *MarginAccount, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/marginAccount",
+		secType:  secTypeSigned,
+	}
+	data, _, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MarginAccount)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarginAsset define the margin of an asset
+type MarginAsset struct {
+	Asset         string `json:"asset"`
+	MarginBalance string `json:"marginBalance"`
+	Equity        string `json:"equity"`
+	Available     string `json:"available"`
+	InitialMargin string `json:"initialMargin"`
+	MaintMargin   string `json:"maintMargin"`
+	UnrealizedPNL string `json:"unrealizedPNL"`
+	LpProfit      string `json:"lpProfit"`
+}
+
+// MarginAccount define the margin account
+type MarginAccount struct {
+	Asset     []*MarginAsset `json:"asset"`
+	Greek     []*Greek       `json:"greek"`
+	RiskLevel string         `json:"riskLevel"`
+	Time      uint64         `json:"time"`
+}
@@ -0,0 +1,78 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type marginAccountServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMarginAccountService(t *testing.T) {
+	suite.Run(t, new(marginAccountServiceTestSuite))
+}
+
+func (s *marginAccountServiceTestSuite) TestMarginAccount() {
+	data := []byte(`{
+		"asset": [
+			{
+				"asset": "USDT",
+				"marginBalance": "10099.448",
+				"equity": "10094.44662",
+				"available": "8725.92524",
+				"initialMargin": "1084.52138",
+				"maintMargin": "151.00138",
+				"unrealizedPNL": "-5.00138",
+				"lpProfit": "-5.00138"
+			}
+		],
+		"greek": [
+			{
+				"underlying": "BTCUSDT",
+				"delta": "-0.05",
+				"gamma": "-0.002",
+				"theta": "-0.05",
+				"vega": "-0.002"
+			}
+		],
+		"time": 1592449455993,
+		"riskLevel": "NORMAL"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest()
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewMarginAccountService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&MarginAccount{
+		Asset: []*MarginAsset{
+			{
+				Asset:         "USDT",
+				MarginBalance: "10099.448",
+				Equity:        "10094.44662",
+				Available:     "8725.92524",
+				InitialMargin: "1084.52138",
+				MaintMargin:   "151.00138",
+				UnrealizedPNL: "-5.00138",
+				LpProfit:      "-5.00138",
+			},
+		},
+		Greek: []*Greek{
+			{
+				Underlying: "BTCUSDT",
+				Delta:      "-0.05",
+				Gamma:      "-0.002",
+				Theta:      "-0.05",
+				Vega:       "-0.002",
+			},
+		},
+		RiskLevel: "NORMAL",
+		Time:      1592449455993,
+	}, res)
+}
@@ -0,0 +1,182 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+// SetMmpService set the market maker protection config of an underlying.
+// The MMP is triggered when the traded quantity or delta of the orders placed with isMmp
+// exceeds the limits within the window, the MMP orders are then cancelled and rejected while frozen.
+type SetMmpService struct {
+	c                        *Client
+	underlying               string
+	windowTimeInMilliseconds int64
+	frozenTimeInMilliseconds int64
+	qtyLimit                 string
+	deltaLimit               string
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *SetMmpService) Underlying(underlying string) *SetMmpService {
+	s.underlying = underlying
+	return s
+}
+
+// WindowTimeInMilliseconds set windowTimeInMilliseconds, the time window of the limits, up to 5000
+func (s *SetMmpService) WindowTimeInMilliseconds(windowTimeInMilliseconds int64) *SetMmpService {
+	s.windowTimeInMilliseconds = windowTimeInMilliseconds
+	return s
+}
+
+// FrozenTimeInMilliseconds set frozenTimeInMilliseconds, 0 freezes the MMP orders until ResetMmpService is called
+func (s *SetMmpService) FrozenTimeInMilliseconds(frozenTimeInMilliseconds int64) *SetMmpService {
+	s.frozenTimeInMilliseconds = frozenTimeInMilliseconds
+	return s
+}
+
+// QtyLimit set qtyLimit
+func (s *SetMmpService) QtyLimit(qtyLimit string) *SetMmpService {
+	s.qtyLimit = qtyLimit
+	return s
+}
+
+// DeltaLimit set deltaLimit
+func (s *SetMmpService) DeltaLimit(deltaLimit string) *SetMmpService {
+	s.deltaLimit = deltaLimit
+	return s
+}
+
+// Do send request
+func (s *SetMmpService) Do(ctx context.Context, opts ...RequestOption) (res *Mmp, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/mmpSet",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(params{
+		"underlying":               s.underlying,
+		"windowTimeInMilliseconds": s.windowTimeInMilliseconds,
+		"frozenTimeInMilliseconds": s.frozenTimeInMilliseconds,
+		"qtyLimit":                 s.qtyLimit,
+		"deltaLimit":               s.deltaLimit,
+	})
+	return s.c.mmp(ctx, r, opts...)
+}
+
+// ResetMmpService reset the market maker protection of an underlying,
+// the MMP orders are accepted again before the end of the frozen time
+type ResetMmpService struct {
+	c          *Client
+	underlying string
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *ResetMmpService) Underlying(underlying string) *ResetMmpService {
+	s.underlying = underlying
+	return s
+}
+
+// Do send request
+func (s *ResetMmpService) Do(ctx context.Context, opts ...RequestOption) (res *Mmp, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/eapi/v1/mmpReset",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("underlying", s.underlying)
+	return s.c.mmp(ctx, r, opts...)
+}
+
+// GetMmpService get the market maker protection config of an underlying
+type GetMmpService struct {
+	c          *Client
+	underlying string
+}
+
+// Underlying set underlying, e.g. BTCUSDT
+func (s *GetMmpService) Underlying(underlying string) *GetMmpService {
+	s.underlying = underlying
+	return s
+}
+
+// Do send request
+func (s *GetMmpService) Do(ctx context.Context, opts ...RequestOption) (res *Mmp, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/eapi/v1/mmp",
+		secType:  secTypeSigned,
+	}
+	r.setParam("underlying", s.underlying)
+	return s.c.mmp(ctx, r, opts...)
+}
+
+func (c *Client) mmp(ctx context.Context, r *request, opts ...RequestOption) (res *Mmp, err error) {
+	data, _, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Mmp)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Mmp define the market maker protection config of an underlying
+type Mmp struct {
+	UnderlyingId             int64  `json:"underlyingId"`
+	Underlying               string `json:"underlying"`
+	WindowTimeInMilliseconds int64  `json:"windowTimeInMilliseconds"`
+	FrozenTimeInMilliseconds int64  `json:"frozenTimeInMilliseconds"`
+	QtyLimit                 string `json:"qtyLimit"`
+	DeltaLimit               string `json:"deltaLimit"`
+	// LastTriggerTime is the time the MMP was last triggered, 0 if it never was
+	LastTriggerTime int64 `json:"lastTriggerTime"`
+}
+
+// TriggeredOrders return the MMP orders of an ORDER_TRADE_UPDATE event canceled by the trigger of m.
+// The event does not tell why an order is canceled: the MMP orders of the underlying canceled from LastTriggerTime
+// until the end of the frozen time are matched, as no MMP order can be placed meanwhile. When the frozen time is 0
+// the MMP is frozen until reset, the orders canceled since LastTriggerTime are matched, m must be fetched after a reset.
+func (m *Mmp) TriggeredOrders(event *WsUserDataEvent) []*WsOrderTradeUpdate {
+	if event.Event != UserDataEventTypeOrderTradeUpdate || m.LastTriggerTime == 0 {
+		return nil
+	}
+	var orders []*WsOrderTradeUpdate
+	for _, o := range event.OTU {
+		if !o.Mmp || o.Status != string(OrderStatusTypeCanceled) || symbolUnderlying(o.Symbol) != m.Underlying {
+			continue
+		}
+		if o.UpdateTime < m.LastTriggerTime ||
+			m.FrozenTimeInMilliseconds > 0 && o.UpdateTime > m.LastTriggerTime+m.FrozenTimeInMilliseconds {
+			continue
+		}
+		orders = append(orders, o)
+	}
+	return orders
+}
+
+// MmpTriggeredOrders return the MMP orders of an ORDER_TRADE_UPDATE event canceled by the market maker protection
+// of underlying, the MMP config is fetched with GetMmpService, see Mmp.TriggeredOrders
+func (c *Client) MmpTriggeredOrders(ctx context.Context, underlying string, event *WsUserDataEvent, opts ...RequestOption) ([]*WsOrderTradeUpdate, error) {
+	if event.Event != UserDataEventTypeOrderTradeUpdate {
+		return nil, nil
+	}
+	mmp, err := c.NewGetMmpService().Underlying(underlying).Do(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return mmp.TriggeredOrders(event), nil
+}
+
+// symbolUnderlying return the underlying of an options symbol, e.g. BTCUSDT for BTC-220930-18000-C
+func symbolUnderlying(symbol string) string {
+	if i := strings.Index(symbol, "-"); i > 0 {
+		return symbol[:i] + "USDT"
+	}
+	return ""
+}
@@ -0,0 +1,155 @@
+package options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type mmpServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestMmpService(t *testing.T) {
+	suite.Run(t, new(mmpServiceTestSuite))
+}
+
+func (s *mmpServiceTestSuite) TestSetMmp() {
+	data := []byte(`{
+		"underlyingId": 2,
+		"underlying": "BTCUSDT",
+		"windowTimeInMilliseconds": 3000,
+		"frozenTimeInMilliseconds": 300000,
+		"qtyLimit": "2",
+		"deltaLimit": "2.3",
+		"lastTriggerTime": 0
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"underlying":               "BTCUSDT",
+			"windowTimeInMilliseconds": 3000,
+			"frozenTimeInMilliseconds": 300000,
+			"qtyLimit":                 "2",
+			"deltaLimit":               "2.3",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewSetMmpService().Underlying("BTCUSDT").
+		WindowTimeInMilliseconds(3000).FrozenTimeInMilliseconds(300000).
+		QtyLimit("2").DeltaLimit("2.3").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&Mmp{
+		UnderlyingId:             2,
+		Underlying:               "BTCUSDT",
+		WindowTimeInMilliseconds: 3000,
+		FrozenTimeInMilliseconds: 300000,
+		QtyLimit:                 "2",
+		DeltaLimit:               "2.3",
+	}, res)
+}
+
+func (s *mmpServiceTestSuite) TestResetMmp() {
+	data := []byte(`{
+		"underlyingId": 2,
+		"underlying": "BTCUSDT",
+		"windowTimeInMilliseconds": 3000,
+		"frozenTimeInMilliseconds": 300000,
+		"qtyLimit": "2",
+		"deltaLimit": "2.3",
+		"lastTriggerTime": 1657613775883
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParam("underlying", "BTCUSDT")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewResetMmpService().Underlying("BTCUSDT").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(1657613775883), res.LastTriggerTime)
+}
+
+func (s *mmpServiceTestSuite) TestGetMmp() {
+	data := []byte(`{
+		"underlyingId": 2,
+		"underlying": "BTCUSDT",
+		"windowTimeInMilliseconds": 3000,
+		"frozenTimeInMilliseconds": 300000,
+		"qtyLimit": "2",
+		"deltaLimit": "2.3",
+		"lastTriggerTime": 0
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("underlying", "BTCUSDT")
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewGetMmpService().Underlying("BTCUSDT").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("BTCUSDT", res.Underlying)
+	r.Equal("2.3", res.DeltaLimit)
+}
+
+func (s *mmpServiceTestSuite) TestMmpTriggeredOrders() {
+	data := []byte(`{
+		"underlyingId": 2,
+		"underlying": "BTCUSDT",
+		"windowTimeInMilliseconds": 3000,
+		"frozenTimeInMilliseconds": 300000,
+		"qtyLimit": "2",
+		"deltaLimit": "2.3",
+		"lastTriggerTime": 1657613775880
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParam("underlying", "BTCUSDT")
+		s.assertRequestEqual(e, r)
+	})
+	event := &WsUserDataEvent{
+		Event: UserDataEventTypeOrderTradeUpdate,
+		OTU: []*WsOrderTradeUpdate{
+			// canceled by the trigger
+			{OrderId: "1", Symbol: "BTC-220930-18000-C", Status: "CANCELED", UpdateTime: 1657613775880, Mmp: true},
+			// canceled manually before the trigger
+			{OrderId: "2", Symbol: "BTC-220930-18000-C", Status: "CANCELED", UpdateTime: 1657613775000, Mmp: true},
+			// canceled manually after the frozen time
+			{OrderId: "3", Symbol: "BTC-220930-18000-C", Status: "CANCELED", UpdateTime: 1657614075881, Mmp: true},
+			// not an MMP order
+			{OrderId: "4", Symbol: "BTC-220930-18000-P", Status: "CANCELED", UpdateTime: 1657613775880},
+			// MMP order of another underlying
+			{OrderId: "5", Symbol: "ETH-220930-1000-C", Status: "CANCELED", UpdateTime: 1657613775880, Mmp: true},
+			{OrderId: "6", Symbol: "BTC-220930-18000-P", Status: "FILLED", UpdateTime: 1657613775880, Mmp: true},
+		},
+	}
+	orders, err := s.client.MmpTriggeredOrders(newContext(), "BTCUSDT", event)
+	r := s.r()
+	r.NoError(err)
+	r.Len(orders, 1)
+	r.Equal("1", orders[0].OrderId)
+}
+
+func (s *mmpServiceTestSuite) TestMmpTriggeredOrdersNeverTriggered() {
+	mmp := &Mmp{Underlying: "BTCUSDT", FrozenTimeInMilliseconds: 0}
+	event := &WsUserDataEvent{
+		Event: UserDataEventTypeOrderTradeUpdate,
+		OTU: []*WsOrderTradeUpdate{
+			{OrderId: "1", Symbol: "BTC-220930-18000-C", Status: "CANCELED", UpdateTime: 1657613775880, Mmp: true},
+		},
+	}
+	s.r().Empty(mmp.TriggeredOrders(event))
+
+	// frozen until reset
+	mmp.LastTriggerTime = 1657613775000
+	s.r().Len(mmp.TriggeredOrders(event), 1)
+}
@@ -0,0 +1,163 @@
+// Package pricing computes the Black-76 price, implied volatility and Greeks of the options contracts,
+// and aggregates the Greeks of the positions by underlying.
+package pricing
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	// daysPerYear is used for the time to expiry and the theta per day
+	daysPerYear = 365
+
+	minImpliedVol      = 1e-6
+	maxImpliedVol      = 10
+	impliedVolTol      = 1e-10
+	impliedVolMaxIters = 100
+)
+
+// ErrNoImpliedVol is returned when the price has no implied volatility, e.g. outside of the no-arbitrage bounds
+var ErrNoImpliedVol = errors.New("pricing: no implied volatility")
+
+// Greeks define the sensitivities of an option price in the Black-76 model
+type Greeks struct {
+	// Delta is the change of the price for a change of 1 of the forward
+	Delta float64
+	// Gamma is the change of Delta for a change of 1 of the forward
+	Gamma float64
+	// Vega is the change of the price for a change of 1% of the volatility
+	Vega float64
+	// Theta is the change of the price after one day
+	Theta float64
+}
+
+// Inputs define the Black-76 model inputs
+type Inputs struct {
+	Call bool
+	// Forward is the forward price of the underlying, see Forward
+	Forward float64
+	Strike  float64
+	// Time is the time to expiry in years
+	Time float64
+	// Vol is the annualized volatility, e.g. 0.5 for 50%
+	Vol float64
+	// Rate is the annualized continuously compounded risk free rate
+	Rate float64
+}
+
+// Forward return the forward price of an underlying at price spot, e.g. its index price
+func Forward(spot, rate, time float64) float64 {
+	return spot * math.Exp(rate*time)
+}
+
+func (in Inputs) expired() bool {
+	return in.Time <= 0 || in.Vol <= 0
+}
+
+func (in Inputs) d1d2() (d1, d2 float64) {
+	sqrtT := math.Sqrt(in.Time)
+	d1 = (math.Log(in.Forward/in.Strike) + in.Vol*in.Vol*in.Time/2) / (in.Vol * sqrtT)
+	return d1, d1 - in.Vol*sqrtT
+}
+
+// Price return the Black-76 price of the option, its discounted intrinsic value once expired
+func Price(in Inputs) float64 {
+	df := math.Exp(-in.Rate * in.Time)
+	if in.expired() {
+		if in.Call {
+			return df * math.Max(in.Forward-in.Strike, 0)
+		}
+		return df * math.Max(in.Strike-in.Forward, 0)
+	}
+	d1, d2 := in.d1d2()
+	if in.Call {
+		return df * (in.Forward*normCDF(d1) - in.Strike*normCDF(d2))
+	}
+	return df * (in.Strike*normCDF(-d2) - in.Forward*normCDF(-d1))
+}
+
+// ComputeGreeks return the Black-76 Greeks of the option, only Delta is set once expired
+func ComputeGreeks(in Inputs) Greeks {
+	df := math.Exp(-in.Rate * in.Time)
+	if in.expired() {
+		var g Greeks
+		switch {
+		case in.Call && in.Forward > in.Strike:
+			g.Delta = df
+		case !in.Call && in.Forward < in.Strike:
+			g.Delta = -df
+		}
+		return g
+	}
+	d1, _ := in.d1d2()
+	sqrtT := math.Sqrt(in.Time)
+	pdf := normPDF(d1)
+	g := Greeks{
+		Gamma: df * pdf / (in.Forward * in.Vol * sqrtT),
+		Vega:  df * in.Forward * pdf * sqrtT / 100,
+	}
+	if in.Call {
+		g.Delta = df * normCDF(d1)
+	} else {
+		g.Delta = -df * normCDF(-d1)
+	}
+	// the forward is held constant, the price grows with the discount factor
+	theta := -df*in.Forward*pdf*in.Vol/(2*sqrtT) + in.Rate*Price(in)
+	g.Theta = theta / daysPerYear
+	return g
+}
+
+// ImpliedVol return the volatility for which the Black-76 price of the option is price,
+// in.Vol is used as the initial guess when set. ErrNoImpliedVol is returned when price
+// is outside of the no-arbitrage bounds or the volatility does not converge.
+func ImpliedVol(in Inputs, price float64) (float64, error) {
+	if in.Time <= 0 {
+		return 0, ErrNoImpliedVol
+	}
+	df := math.Exp(-in.Rate * in.Time)
+	lower, upper := df*math.Max(in.Forward-in.Strike, 0), df*in.Forward
+	if !in.Call {
+		lower, upper = df*math.Max(in.Strike-in.Forward, 0), df*in.Strike
+	}
+	if price <= lower || price >= upper {
+		return 0, ErrNoImpliedVol
+	}
+
+	// Newton steps safeguarded by bisection, the price is increasing in the volatility
+	lo, hi := minImpliedVol, float64(maxImpliedVol)
+	vol := in.Vol
+	if vol <= lo || vol >= hi {
+		vol = 0.5
+	}
+	for i := 0; i < impliedVolMaxIters; i++ {
+		in.Vol = vol
+		diff := Price(in) - price
+		if diff == 0 {
+			return vol, nil
+		}
+		if diff > 0 {
+			hi = vol
+		} else {
+			lo = vol
+		}
+		vega := ComputeGreeks(in).Vega * 100
+		next := vol - diff/vega
+		if vega <= 0 || math.IsNaN(next) || next <= lo || next >= hi {
+			next = (lo + hi) / 2
+		}
+		if math.Abs(next-vol) < impliedVolTol {
+			return next, nil
+		}
+		vol = next
+	}
+	return 0, ErrNoImpliedVol
+}
+
+func normCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+func normPDF(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
@@ -0,0 +1,83 @@
+package pricing
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrice(t *testing.T) {
+	in := Inputs{Call: true, Forward: 100, Strike: 100, Time: 1, Vol: 0.2}
+	assert.InDelta(t, 7.965567, Price(in), 1e-6)
+
+	// put-call parity: C - P = e^{-rT}(F - K)
+	in = Inputs{Call: true, Forward: 105, Strike: 95, Time: 0.5, Vol: 0.6, Rate: 0.03}
+	call := Price(in)
+	in.Call = false
+	put := Price(in)
+	assert.InDelta(t, math.Exp(-0.03*0.5)*10, call-put, 1e-9)
+}
+
+func TestPriceExpired(t *testing.T) {
+	assert.Equal(t, 5.0, Price(Inputs{Call: true, Forward: 105, Strike: 100}))
+	assert.Equal(t, 0.0, Price(Inputs{Call: false, Forward: 105, Strike: 100}))
+	assert.Equal(t, Greeks{Delta: 1}, ComputeGreeks(Inputs{Call: true, Forward: 105, Strike: 100}))
+	assert.Equal(t, Greeks{}, ComputeGreeks(Inputs{Call: false, Forward: 105, Strike: 100}))
+}
+
+func TestComputeGreeks(t *testing.T) {
+	for _, call := range []bool{true, false} {
+		in := Inputs{Call: call, Forward: 30000, Strike: 32000, Time: 0.25, Vol: 0.55, Rate: 0.02}
+		g := ComputeGreeks(in)
+
+		const dF, dV = 1e-2, 1e-5
+		bump := func(f func(*Inputs)) float64 {
+			b := in
+			f(&b)
+			return Price(b)
+		}
+		up := bump(func(b *Inputs) { b.Forward += dF })
+		down := bump(func(b *Inputs) { b.Forward -= dF })
+		assert.InDelta(t, (up-down)/(2*dF), g.Delta, 1e-6)
+		assert.InDelta(t, (up-2*Price(in)+down)/(dF*dF), g.Gamma, 1e-6)
+
+		volUp := bump(func(b *Inputs) { b.Vol += dV })
+		volDown := bump(func(b *Inputs) { b.Vol -= dV })
+		assert.InDelta(t, (volUp-volDown)/(2*dV)/100, g.Vega, 1e-4)
+
+		day := 1.0 / daysPerYear
+		tomorrow := bump(func(b *Inputs) { b.Time -= day })
+		assert.InDelta(t, tomorrow-Price(in), g.Theta, 0.05)
+	}
+}
+
+func TestImpliedVol(t *testing.T) {
+	for _, vol := range []float64{0.05, 0.3, 0.8, 2.5} {
+		for _, call := range []bool{true, false} {
+			in := Inputs{Call: call, Forward: 2000, Strike: 2200, Time: 0.1, Vol: vol, Rate: 0.01}
+			price := Price(in)
+			in.Vol = 0
+			iv, err := ImpliedVol(in, price)
+			require.NoError(t, err)
+			assert.InDelta(t, vol, iv, 1e-6)
+		}
+	}
+}
+
+func TestImpliedVolOutOfBounds(t *testing.T) {
+	in := Inputs{Call: true, Forward: 110, Strike: 100, Time: 0.5}
+	_, err := ImpliedVol(in, 9)
+	assert.ErrorIs(t, err, ErrNoImpliedVol)
+	_, err = ImpliedVol(in, 120)
+	assert.ErrorIs(t, err, ErrNoImpliedVol)
+	in.Time = 0
+	_, err = ImpliedVol(in, 11)
+	assert.ErrorIs(t, err, ErrNoImpliedVol)
+}
+
+func TestForward(t *testing.T) {
+	assert.Equal(t, 100.0, Forward(100, 0, 1))
+	assert.InDelta(t, 100*math.Exp(0.05*0.5), Forward(100, 0.05, 0.5), 1e-12)
+}
@@ -0,0 +1,86 @@
+package pricing
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/adshao/go-binance/v2/options"
+)
+
+// Book define the contracts of the exchange info by symbol
+type Book struct {
+	contracts map[string]*Contract
+}
+
+// NewBook init a Book from the options.ExchangeInfo option symbols
+func NewBook(symbols []options.OptionSymbol) (*Book, error) {
+	b := &Book{contracts: make(map[string]*Contract, len(symbols))}
+	for i := range symbols {
+		c, err := NewContract(&symbols[i])
+		if err != nil {
+			return nil, err
+		}
+		b.contracts[c.Symbol] = c
+	}
+	return b, nil
+}
+
+// Contract return the contract of symbol, nil if unknown
+func (b *Book) Contract(symbol string) *Contract {
+	return b.contracts[symbol]
+}
+
+// PositionGreeks define the Greeks of the positions of an underlying,
+// in units of the underlying, e.g. Delta is the equivalent quantity of the underlying
+type PositionGreeks struct {
+	Underlying string
+	Greeks
+}
+
+// Aggregate sum the Greeks of the options.Position by underlying, computed at the implied volatility
+// of the options.Mark of each symbol. forwards map the underlying to its forward price, see Forward.
+func (b *Book) Aggregate(positions []*options.Position, marks []*options.Mark, forwards map[string]float64,
+	now time.Time) (map[string]*PositionGreeks, error) {
+	marksBySymbol := make(map[string]*options.Mark, len(marks))
+	for _, m := range marks {
+		marksBySymbol[m.Symbol] = m
+	}
+	res := make(map[string]*PositionGreeks)
+	for _, p := range positions {
+		c := b.Contract(p.Symbol)
+		if c == nil {
+			return nil, fmt.Errorf("pricing: %s: unknown symbol", p.Symbol)
+		}
+		mark, ok := marksBySymbol[p.Symbol]
+		if !ok {
+			return nil, fmt.Errorf("pricing: %s: no mark", p.Symbol)
+		}
+		forward, ok := forwards[c.Underlying]
+		if !ok {
+			return nil, fmt.Errorf("pricing: %s: no forward for %s", p.Symbol, c.Underlying)
+		}
+		qty, err := parseFloat("quantity", p.Quantity)
+		if err != nil {
+			return nil, fmt.Errorf("pricing: %s: %w", p.Symbol, err)
+		}
+		v, err := c.Value(mark, forward, now)
+		if err != nil {
+			return nil, err
+		}
+		size := math.Abs(qty) * c.Unit
+		if options.PositionSideType(p.Side) == options.PositionSideTypeShort {
+			size = -size
+		}
+		g, ok := res[c.Underlying]
+		if !ok {
+			g = &PositionGreeks{Underlying: c.Underlying}
+			res[c.Underlying] = g
+		}
+		g.Delta += size * v.Greeks.Delta
+		g.Gamma += size * v.Greeks.Gamma
+		g.Vega += size * v.Greeks.Vega
+		g.Theta += size * v.Greeks.Theta
+	}
+	return res, nil
+}
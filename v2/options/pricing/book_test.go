@@ -0,0 +1,153 @@
+package pricing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/adshao/go-binance/v2/options"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testNow    = time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	testExpiry = testNow.Add(30 * 24 * time.Hour)
+)
+
+func testSymbols() []options.OptionSymbol {
+	return []options.OptionSymbol{
+		{
+			Symbol:      "BTC-240131-45000-C",
+			Side:        "CALL",
+			StrikePrice: "45000",
+			Underlying:  "BTCUSDT",
+			Unit:        1,
+			ExpiryDate:  testExpiry.UnixMilli(),
+		},
+		{
+			Symbol:      "BTC-240131-40000-P",
+			Side:        "PUT",
+			StrikePrice: "40000.000",
+			Underlying:  "BTCUSDT",
+			Unit:        1,
+			ExpiryDate:  testExpiry.UnixMilli(),
+		},
+		{
+			Symbol:      "ETH-240131-2400-C",
+			Side:        "CALL",
+			StrikePrice: "2400",
+			Underlying:  "ETHUSDT",
+			Unit:        1,
+			ExpiryDate:  testExpiry.UnixMilli(),
+		},
+	}
+}
+
+func TestNewContract(t *testing.T) {
+	symbols := testSymbols()
+	c, err := NewContract(&symbols[1])
+	require.NoError(t, err)
+	assert.Equal(t, &Contract{
+		Symbol:     "BTC-240131-40000-P",
+		Underlying: "BTCUSDT",
+		Call:       false,
+		Strike:     40000,
+		Expiry:     time.UnixMilli(testExpiry.UnixMilli()),
+		Unit:       1,
+	}, c)
+	assert.InDelta(t, 30.0/365, c.TimeToExpiry(testNow), 1e-12)
+	assert.Equal(t, 0.0, c.TimeToExpiry(testExpiry.Add(time.Second)))
+
+	_, err = NewContract(&options.OptionSymbol{Symbol: "X", Side: "BOTH", StrikePrice: "1"})
+	assert.Error(t, err)
+	_, err = NewContract(&options.OptionSymbol{Symbol: "X", Side: "CALL", StrikePrice: "abc"})
+	assert.Error(t, err)
+}
+
+func TestContractValue(t *testing.T) {
+	symbols := testSymbols()
+	c, err := NewContract(&symbols[0])
+	require.NoError(t, err)
+
+	forward := 42000.0
+	in := c.Inputs(forward, 0.5, 0.01, testNow)
+	mark := &options.Mark{
+		Symbol:           c.Symbol,
+		MarkPrice:        "1000",
+		MarkIV:           "0.5",
+		RiskFreeInterest: "0.01",
+	}
+	v, err := c.Value(mark, forward, testNow)
+	require.NoError(t, err)
+	assert.InDelta(t, Price(in), v.Price, 1e-9)
+	assert.Equal(t, ComputeGreeks(in), v.Greeks)
+	in.Vol = v.ImpliedVol
+	assert.InDelta(t, 1000, Price(in), 1e-6)
+
+	// no implied volatility below the intrinsic value
+	mark.MarkPrice = "0"
+	v, err = c.Value(mark, forward, testNow)
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, v.ImpliedVol)
+
+	mark.MarkIV = "abc"
+	_, err = c.Value(mark, forward, testNow)
+	assert.Error(t, err)
+
+	// a live contract needs a mark implied volatility
+	for _, markIV := range []string{"", "0", "-0.1"} {
+		mark.MarkIV = markIV
+		_, err = c.Value(mark, forward, testNow)
+		assert.Error(t, err, markIV)
+	}
+	// an expired contract is valued at its intrinsic value
+	v, err = c.Value(mark, forward, testExpiry.Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, v.Price)
+	assert.Equal(t, Greeks{}, v.Greeks)
+}
+
+func TestBookAggregate(t *testing.T) {
+	b, err := NewBook(testSymbols())
+	require.NoError(t, err)
+	assert.Nil(t, b.Contract("BTC-240131-50000-C"))
+
+	marks := []*options.Mark{
+		{Symbol: "BTC-240131-45000-C", MarkPrice: "800", MarkIV: "0.5", RiskFreeInterest: "0.01"},
+		{Symbol: "BTC-240131-40000-P", MarkPrice: "900", MarkIV: "0.55", RiskFreeInterest: "0.01"},
+		{Symbol: "ETH-240131-2400-C", MarkPrice: "90", MarkIV: "0.6", RiskFreeInterest: "0.01"},
+	}
+	positions := []*options.Position{
+		{Symbol: "BTC-240131-45000-C", Side: "LONG", Quantity: "2"},
+		{Symbol: "BTC-240131-40000-P", Side: "SHORT", Quantity: "-0.5"},
+		{Symbol: "ETH-240131-2400-C", Side: "LONG", Quantity: "10"},
+	}
+	forwards := map[string]float64{"BTCUSDT": 42000, "ETHUSDT": 2300}
+
+	res, err := b.Aggregate(positions, marks, forwards, testNow)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	greeks := func(symbol string, vol, forward float64) Greeks {
+		return ComputeGreeks(b.Contract(symbol).Inputs(forward, vol, 0.01, testNow))
+	}
+	call := greeks("BTC-240131-45000-C", 0.5, 42000)
+	put := greeks("BTC-240131-40000-P", 0.55, 42000)
+	btc := res["BTCUSDT"]
+	assert.Equal(t, "BTCUSDT", btc.Underlying)
+	assert.InDelta(t, 2*call.Delta-0.5*put.Delta, btc.Delta, 1e-9)
+	assert.InDelta(t, 2*call.Gamma-0.5*put.Gamma, btc.Gamma, 1e-12)
+	assert.InDelta(t, 2*call.Vega-0.5*put.Vega, btc.Vega, 1e-9)
+	assert.InDelta(t, 2*call.Theta-0.5*put.Theta, btc.Theta, 1e-9)
+	assert.Greater(t, btc.Delta, 0.0)
+
+	eth := greeks("ETH-240131-2400-C", 0.6, 2300)
+	assert.InDelta(t, 10*eth.Delta, res["ETHUSDT"].Delta, 1e-9)
+
+	_, err = b.Aggregate(positions, marks[:2], forwards, testNow)
+	assert.Error(t, err)
+	_, err = b.Aggregate(positions, marks, map[string]float64{"BTCUSDT": 42000}, testNow)
+	assert.Error(t, err)
+	_, err = b.Aggregate([]*options.Position{{Symbol: "UNKNOWN"}}, marks, forwards, testNow)
+	assert.Error(t, err)
+}
@@ -0,0 +1,122 @@
+package pricing
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/adshao/go-binance/v2/options"
+)
+
+// Contract define the terms of an options contract
+type Contract struct {
+	Symbol     string
+	Underlying string
+	Call       bool
+	Strike     float64
+	Expiry     time.Time
+	// Unit is the quantity of the underlying of one contract
+	Unit float64
+}
+
+// NewContract init a Contract from an options.OptionSymbol of the exchange info
+func NewContract(symbol *options.OptionSymbol) (*Contract, error) {
+	strike, err := parseFloat("strikePrice", symbol.StrikePrice)
+	if err != nil {
+		return nil, fmt.Errorf("pricing: %s: %w", symbol.Symbol, err)
+	}
+	var call bool
+	switch options.OptionSideType(symbol.Side) {
+	case options.OptionSideTypeCall:
+		call = true
+	case options.OptionSideTypePut:
+	default:
+		return nil, fmt.Errorf("pricing: %s: unknown side %q", symbol.Symbol, symbol.Side)
+	}
+	unit := float64(symbol.Unit)
+	if unit == 0 {
+		unit = 1
+	}
+	return &Contract{
+		Symbol:     symbol.Symbol,
+		Underlying: symbol.Underlying,
+		Call:       call,
+		Strike:     strike,
+		Expiry:     time.UnixMilli(symbol.ExpiryDate),
+		Unit:       unit,
+	}, nil
+}
+
+// TimeToExpiry return the time to expiry in years at now, 0 once expired
+func (c *Contract) TimeToExpiry(now time.Time) float64 {
+	d := c.Expiry.Sub(now)
+	if d <= 0 {
+		return 0
+	}
+	return d.Hours() / 24 / daysPerYear
+}
+
+// Inputs return the Black-76 model inputs of the contract at now
+func (c *Contract) Inputs(forward, vol, rate float64, now time.Time) Inputs {
+	return Inputs{
+		Call:    c.Call,
+		Forward: forward,
+		Strike:  c.Strike,
+		Time:    c.TimeToExpiry(now),
+		Vol:     vol,
+		Rate:    rate,
+	}
+}
+
+// Valuation define the price, implied volatility and Greeks of one contract
+type Valuation struct {
+	// Price is the price at the mark implied volatility
+	Price float64
+	// ImpliedVol is the implied volatility of the mark price, 0 when it has none
+	ImpliedVol float64
+	// Greeks are computed at the mark implied volatility
+	Greeks Greeks
+}
+
+// Value price the contract from its options.Mark, forward is the forward price of the underlying
+// at the expiry of the contract, see Forward and options.Index. The markIV of a contract which has not expired
+// must be positive.
+func (c *Contract) Value(mark *options.Mark, forward float64, now time.Time) (*Valuation, error) {
+	markPrice, err := parseFloat("markPrice", mark.MarkPrice)
+	if err != nil {
+		return nil, fmt.Errorf("pricing: %s: %w", c.Symbol, err)
+	}
+	markIV, err := parseFloat("markIV", mark.MarkIV)
+	if err != nil {
+		return nil, fmt.Errorf("pricing: %s: %w", c.Symbol, err)
+	}
+	// a live contract priced at a zero volatility would be valued at its intrinsic value without Greeks
+	if !(markIV > 0) && c.TimeToExpiry(now) > 0 {
+		return nil, fmt.Errorf("pricing: %s: missing or not positive markIV %q", c.Symbol, mark.MarkIV)
+	}
+	rate, err := parseFloat("riskFreeInterest", mark.RiskFreeInterest)
+	if err != nil {
+		return nil, fmt.Errorf("pricing: %s: %w", c.Symbol, err)
+	}
+	in := c.Inputs(forward, markIV, rate, now)
+	v := &Valuation{
+		Price:  Price(in),
+		Greeks: ComputeGreeks(in),
+	}
+	if iv, err := ImpliedVol(in, markPrice); err == nil {
+		v.ImpliedVol = iv
+	}
+	return v, nil
+}
+
+// parseFloat parse an optional decimal string, the empty string is 0
+func parseFloat(name, s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", name, s, err)
+	}
+	return f, nil
+}
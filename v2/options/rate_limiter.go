@@ -0,0 +1,69 @@
+package options
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// NewRateLimiter init a client side rate limiter seeded with the rate limits of ExchangeInfo.
+// Assign it to Client.RateLimiter to enable it.
+func NewRateLimiter(rateLimits []RateLimit) *common.RateLimiter {
+	limits := make([]common.RateLimit, 0, len(rateLimits))
+	for _, rl := range rateLimits {
+		limits = append(limits, common.RateLimit(rl))
+	}
+	return common.NewRateLimiter(limits...)
+}
+
+// requestWeights define the REQUEST_WEIGHT of the /eapi endpoints, the default weight is 1
+var requestWeights = map[string]int64{
+	http.MethodGet + " /eapi/v1/historicalTrades":             20,
+	http.MethodGet + " /eapi/v1/exerciseHistory":              3,
+	http.MethodGet + " /eapi/v1/openInterest":                 0,
+	http.MethodGet + " /eapi/v1/account":                      3,
+	http.MethodGet + " /eapi/v1/historyOrders":                3,
+	http.MethodGet + " /eapi/v1/position":                     5,
+	http.MethodGet + " /eapi/v1/userTrades":                   5,
+	http.MethodGet + " /eapi/v1/exerciseRecord":               5,
+	http.MethodGet + " /eapi/v1/bill":                         1,
+	http.MethodDelete + " /eapi/v1/allOpenOrders":             1,
+	http.MethodDelete + " /eapi/v1/batchOrders":               1,
+	http.MethodPost + " /eapi/v1/batchOrders":                 5,
+	http.MethodGet + " /eapi/v1/income/asyn":                  5,
+	http.MethodGet + " /eapi/v1/income/asyn/id":               5,
+	http.MethodDelete + " /eapi/v1/allOpenOrdersByUnderlying": 1,
+	http.MethodPost + " /eapi/v1/countdownCancelAllHeartBeat": 10,
+	http.MethodGet + " /eapi/v1/marginAccount":                3,
+	http.MethodGet + " /eapi/v1/blockTrades":                  5,
+	http.MethodGet + " /eapi/v1/block/user-trades":            5,
+}
+
+// orderEndpoints define the endpoints counting against the ORDERS rate limits
+var orderEndpoints = map[string]int64{
+	http.MethodPost + " /eapi/v1/order": 1,
+}
+
+// requestCost return the REQUEST_WEIGHT and ORDERS cost of the request
+func requestCost(r *request) (weight, orders int64) {
+	if !strings.HasPrefix(r.endpoint, "/eapi/") {
+		return 0, 0
+	}
+	key := r.method + " " + r.endpoint
+	orders = orderEndpoints[key]
+	if key == http.MethodPost+" /eapi/v1/batchOrders" {
+		orders = int64(strings.Count(r.form.Get("orders"), "{"))
+	}
+	switch key {
+	case http.MethodGet + " /eapi/v1/openOrders":
+		if r.query.Get("symbol") != "" {
+			return 1, orders
+		}
+		return 40, orders
+	}
+	if w, ok := requestWeights[key]; ok {
+		return w, orders
+	}
+	return 1, orders
+}
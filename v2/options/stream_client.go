@@ -0,0 +1,74 @@
+package options
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// StreamClient subscribes to the market streams at runtime on a single connection,
+// the typed Subscribe* methods decode the payloads into the events of the Ws*Serve functions
+type StreamClient struct {
+	*common.StreamClient
+}
+
+// NewStreamClient init a StreamClient, Connect must be called before subscribing
+func NewStreamClient() *StreamClient {
+	endpoint := strings.TrimSuffix(getCombinedEndpoint(), "?streams=")
+	return &StreamClient{common.NewStreamClient(endpoint, getWsProxyUrl())}
+}
+
+// SubscribeTrade subscribes to the trades of an option symbol or an underlying like BTC
+func (c *StreamClient) SubscribeTrade(ctx context.Context, symbol string, handler WsTradeHandler) error {
+	stream := fmt.Sprintf("%s@trade", strings.ToUpper(symbol))
+	return c.Subscribe(ctx, func(stream string, data []byte) error {
+		event := new(WsTradeEvent)
+		if err := json.Unmarshal(data, event); err != nil {
+			return err
+		}
+		handler(event)
+		return nil
+	}, stream)
+}
+
+// SubscribeKline subscribes to the klines of an option symbol with an interval like 15m
+func (c *StreamClient) SubscribeKline(ctx context.Context, symbol string, interval string, handler WsKlineHandler) error {
+	stream := fmt.Sprintf("%s@kline_%s", strings.ToUpper(symbol), interval)
+	return c.Subscribe(ctx, func(stream string, data []byte) error {
+		event := new(WsKlineEvent)
+		if err := json.Unmarshal(data, event); err != nil {
+			return err
+		}
+		handler(event)
+		return nil
+	}, stream)
+}
+
+// SubscribeIndex subscribes to the index price of an underlying like ETHUSDT
+func (c *StreamClient) SubscribeIndex(ctx context.Context, symbol string, handler WsIndexHandler) error {
+	stream := fmt.Sprintf("%s@index", strings.ToUpper(symbol))
+	return c.Subscribe(ctx, func(stream string, data []byte) error {
+		event := new(WsIndexEvent)
+		if err := json.Unmarshal(data, event); err != nil {
+			return err
+		}
+		handler(event)
+		return nil
+	}, stream)
+}
+
+// SubscribeMarkPrice subscribes to the mark prices of the options of an underlying like ETH
+func (c *StreamClient) SubscribeMarkPrice(ctx context.Context, underlying string, handler WsMarkPriceHandler) error {
+	stream := fmt.Sprintf("%s@markPrice", strings.ToUpper(underlying))
+	return c.Subscribe(ctx, func(stream string, data []byte) error {
+		events := make([]*WsMarkPriceEvent, 0)
+		if err := json.Unmarshal(data, &events); err != nil {
+			return err
+		}
+		handler(events)
+		return nil
+	}, stream)
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // Endpoints
@@ -23,6 +25,9 @@ var (
 	WebsocketPongTimeout = time.Second * 10
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = true
+	// WebsocketReconnectPolicy enables the reconnection of the Ws*Serve streams when the connection is lost,
+	// nil by default, see common.NewWsReconnectPolicy
+	WebsocketReconnectPolicy *common.WsReconnectPolicy
 	// UseTestnet switch all the WS streams from production to the testnet
 	UseTestnet = false
 
@@ -771,12 +776,20 @@ type WsOrderTradeUpdate struct {
 	TimeInForce   string     `json:"tif"`
 	OrderType     string     `json:"oty"`
 	Filled        []WsFilled `json:"fi"`
+	// Mmp is set for the orders placed with isMmp, see SetMmpService
+	Mmp bool `json:"mmp"`
 }
 
 // WsUserDataHandler handle WsUserDataEvent
 type WsUserDataHandler func(event *WsUserDataEvent)
 
 func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return wsUserDataServe(wsServe, listenKey, handler, errHandler)
+}
+
+// wsUserDataServe connect to the user data stream using serve, wsServe or wsConnect
+func wsUserDataServe(serve func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error),
+	listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
 	cfg := newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
@@ -788,5 +801,5 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 		}
 		handler(event)
 	}
-	return wsServe(cfg, wsHandler, errHandler)
+	return serve(cfg, wsHandler, errHandler)
 }
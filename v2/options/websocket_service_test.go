@@ -1126,6 +1126,7 @@ func (s *websocketServiceTestSuite) assertWsUserDataEvent(e, a *WsUserDataEvent)
 			r.Equal(e.OTU[j].Fee, a.OTU[j].Fee)
 			r.Equal(e.OTU[j].TimeInForce, a.OTU[j].TimeInForce)
 			r.Equal(e.OTU[j].OrderType, a.OTU[j].OrderType)
+			r.Equal(e.OTU[j].Mmp, a.OTU[j].Mmp)
 			for i, _ := range e.OTU[j].Filled {
 				r.Equal(e.OTU[j].Filled[i].TradeId, a.OTU[j].Filled[i].TradeId)
 				r.Equal(e.OTU[j].Filled[i].Price, a.OTU[j].Filled[i].Price)
@@ -1408,3 +1409,68 @@ func (s *websocketServiceTestSuite) TestUserDataServe4() {
 	stopC <- struct{}{}
 	<-doneC
 }
+
+func (s *websocketServiceTestSuite) TestUserDataServeMmpOrders() {
+	data := []byte(`{
+		"e":"ORDER_TRADE_UPDATE",
+		"E":1657613775883,
+		"o":[
+		  {
+			"T":1657613342918,
+			"t":1657613775880,
+			"s":"BTC-220930-18000-C",
+			"c":"",
+			"oid":"4611869636869226548",
+			"p":"1993",
+			"q":"1",
+			"stp":0,
+			"r":false,
+			"po":true,
+			"S":"CANCELED",
+			"e":"0",
+			"ec":"0",
+			"f":"0",
+			"tif":"GTC",
+			"oty":"LIMIT",
+			"fi":[],
+			"mmp":true
+		  },
+		  {
+			"T":1657613342918,
+			"t":1657613775880,
+			"s":"BTC-220930-18000-P",
+			"c":"",
+			"oid":"4611869636869226549",
+			"p":"120",
+			"q":"1",
+			"stp":0,
+			"r":false,
+			"po":true,
+			"S":"CANCELED",
+			"e":"0",
+			"ec":"0",
+			"f":"0",
+			"tif":"GTC",
+			"oty":"LIMIT",
+			"fi":[]
+		  }
+		]
+	  }`)
+	fakeErrMsg := "fake error"
+	s.mockWsServe(data, errors.New(fakeErrMsg))
+	defer s.assertWsServe()
+
+	doneC, stopC, err := WsUserDataServe("xxyyzz", func(event *WsUserDataEvent) {
+		r := s.r()
+		r.Len(event.OTU, 2)
+		r.True(event.OTU[0].Mmp)
+		r.False(event.OTU[1].Mmp)
+	},
+		func(err error) {
+			s.r().EqualError(err, fakeErrMsg)
+		})
+
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+}
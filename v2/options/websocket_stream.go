@@ -0,0 +1,31 @@
+package options
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// The Ws*Stream functions are the context based variants of the Ws*Serve functions, see common.Stream.
+// The other streams can be wrapped with common.Stream too.
+
+// WsKlineStream is similar to WsKlineServe, but it sends the events to a channel
+func WsKlineStream(ctx context.Context, symbol string, interval string, opts *common.StreamOptions) (<-chan *WsKlineEvent, <-chan error, error) {
+	return common.Stream(ctx, func(handler func(event *WsKlineEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsKlineServe(symbol, interval, handler, errHandler)
+	}, opts)
+}
+
+// WsTradeStream is similar to WsTradeServe, but it sends the events to a channel
+func WsTradeStream(ctx context.Context, symbol string, opts *common.StreamOptions) (<-chan *WsTradeEvent, <-chan error, error) {
+	return common.Stream(ctx, func(handler func(event *WsTradeEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsTradeServe(symbol, handler, errHandler)
+	}, opts)
+}
+
+// WsUserDataStream is similar to WsUserDataServe, but it sends the events to a channel
+func WsUserDataStream(ctx context.Context, listenKey string, opts *common.StreamOptions) (<-chan *WsUserDataEvent, <-chan error, error) {
+	return common.Stream(ctx, func(handler func(event *WsUserDataEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServe(listenKey, handler, errHandler)
+	}, opts)
+}
@@ -0,0 +1,83 @@
+package binance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type orderBookTestSuite struct {
+	baseTestSuite
+	origWsServe func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error)
+	wsHandler   WsHandler
+	endpoint    string
+}
+
+func TestOrderBook(t *testing.T) {
+	suite.Run(t, new(orderBookTestSuite))
+}
+
+func (s *orderBookTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.origWsServe = wsServe
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		s.endpoint = cfg.Endpoint
+		s.wsHandler = handler
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		return doneC, stopC, nil
+	}
+}
+
+func (s *orderBookTestSuite) TearDownTest() {
+	wsServe = s.origWsServe
+}
+
+func (s *orderBookTestSuite) TestServe() {
+	s.mockDo([]byte(`{
+		"lastUpdateId": 102,
+		"bids": [["4.00000000", "431.00000000"], ["3.90000000", "10.00000000"]],
+		"asks": [["4.00000200", "12.00000000"], ["4.10000000", "1.00000000"]]
+	}`), nil)
+	s.assertReq(func(r *request) {
+		s.r().Equal("LTCBTC", r.query.Get("symbol"))
+		s.r().Equal("500", r.query.Get("limit"))
+	})
+
+	changes := make(chan int64, 10)
+	book := s.client.NewOrderBook("LTCBTC").Limit(500).Rate(100 * time.Millisecond).OnChange(func(book *OrderBook) {
+		changes <- book.LastUpdateID()
+	})
+	doneC, stopC, err := book.Serve()
+	s.r().NoError(err)
+	s.r().Contains(s.endpoint, "ltcbtc@depth@100ms")
+
+	s.wsHandler([]byte(`{"e":"depthUpdate","E":1,"s":"LTCBTC","U":101,"u":103,"b":[["4.00000000","0"]],"a":[]}`))
+	s.r().Equal(int64(103), <-changes)
+	s.assertDo()
+
+	bid, ok := book.BestBid()
+	s.r().True(ok)
+	s.r().Equal(common.PriceLevel{Price: "3.90000000", Quantity: "10.00000000"}, bid)
+	ask, ok := book.BestAsk()
+	s.r().True(ok)
+	s.r().Equal(common.PriceLevel{Price: "4.00000200", Quantity: "12.00000000"}, ask)
+
+	s.wsHandler([]byte(`{"e":"depthUpdate","E":2,"s":"LTCBTC","U":104,"u":104,"b":[],"a":[["4.05000000","2.00000000"]]}`))
+	s.r().Equal(int64(104), <-changes)
+	s.r().Equal([]Ask{
+		{Price: "4.00000200", Quantity: "12.00000000"},
+		{Price: "4.05000000", Quantity: "2.00000000"},
+	}, book.Asks(2))
+
+	close(stopC)
+	<-doneC
+	s.r().False(book.IsSynced())
+}
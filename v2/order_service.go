@@ -104,7 +104,8 @@ func (s *CreateOrderService) SelfTradePreventionMode(selfTradePreventionMode Sel
 	return s
 }
 
-func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+// createOrder send the order to endpoint, computeCommissionRates is only accepted by the test endpoint
+func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, computeCommissionRates bool, opts ...RequestOption) (data []byte, err error) {
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: endpoint,
@@ -147,6 +148,9 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.selfTradePreventionMode != nil {
 		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
 	}
+	if computeCommissionRates {
+		m["computeCommissionRates"] = true
+	}
 	r.setFormParams(m)
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -157,7 +161,7 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 
 // Do send request
 func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponse, err error) {
-	data, err := s.createOrder(ctx, "/api/v3/order", opts...)
+	data, err := s.createOrder(ctx, "/api/v3/order", false, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -171,10 +175,32 @@ func (s *CreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 
 // Test send test api to check if the request is valid
 func (s *CreateOrderService) Test(ctx context.Context, opts ...RequestOption) (err error) {
-	_, err = s.createOrder(ctx, "/api/v3/order/test", opts...)
+	_, err = s.createOrder(ctx, "/api/v3/order/test", false, opts...)
 	return err
 }
 
+// TestCommissionRates send test api to check if the request is valid
+// and return the commission rates which would apply to the order
+func (s *CreateOrderService) TestCommissionRates(ctx context.Context, opts ...RequestOption) (res *OrderTestCommissionRates, err error) {
+	data, err := s.createOrder(ctx, "/api/v3/order/test", true, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OrderTestCommissionRates)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OrderTestCommissionRates define the commission rates returned by a test order
+type OrderTestCommissionRates struct {
+	StandardCommissionForOrder CommissionGroup `json:"standardCommissionForOrder"`
+	TaxCommissionForOrder      CommissionGroup `json:"taxCommissionForOrder"`
+	Discount                   DiscountInfo    `json:"discount"`
+}
+
 // CreateOrderResponse define create order response
 type CreateOrderResponse struct {
 	Symbol                   string `json:"symbol"`
@@ -208,6 +234,8 @@ type Fill struct {
 	Quantity        string `json:"qty"`
 	Commission      string `json:"commission"`
 	CommissionAsset string `json:"commissionAsset"`
+	MatchType       string `json:"matchType"` // ONE_PARTY_TRADE_REPORT for SOR orders filled by an allocation
+	AllocID         int64  `json:"allocId"`
 }
 
 // CreateOCOService create order
@@ -366,7 +394,20 @@ func (s *CreateOCOService) Do(ctx context.Context, opts ...RequestOption) (res *
 	return res, nil
 }
 
-// CreateOCOResponse define create order response
+// OrderListResponse define order list response, shared by OCO, OTO and OTOCO order lists
+type OrderListResponse struct {
+	OrderListID       int64               `json:"orderListId"`
+	ContingencyType   ContingencyType     `json:"contingencyType"`
+	ListStatusType    ListStatusType      `json:"listStatusType"`
+	ListOrderStatus   ListOrderStatusType `json:"listOrderStatus"`
+	ListClientOrderID string              `json:"listClientOrderId"`
+	TransactionTime   int64               `json:"transactionTime"`
+	Symbol            string              `json:"symbol"`
+	Orders            []*OCOOrder         `json:"orders"`
+	OrderReports      []*OCOOrderReport   `json:"orderReports"`
+}
+
+// CreateOCOResponse define create order response, see OrderListResponse for the typed list statuses
 type CreateOCOResponse struct {
 	OrderListID       int64             `json:"orderListId"`
 	ContingencyType   string            `json:"contingencyType"`
@@ -379,14 +420,14 @@ type CreateOCOResponse struct {
 	OrderReports      []*OCOOrderReport `json:"orderReports"`
 }
 
-// OCOOrder may be returned in an array of OCOOrder in a CreateOCOResponse.
+// OCOOrder may be returned in an array of OCOOrder in an OrderListResponse.
 type OCOOrder struct {
 	Symbol        string `json:"symbol"`
 	OrderID       int64  `json:"orderId"`
 	ClientOrderID string `json:"clientOrderId"`
 }
 
-// OCOOrderReport may be returned in an array of OCOOrderReport in a CreateOCOResponse.
+// OCOOrderReport may be returned in an array of OCOOrderReport in an OrderListResponse.
 type OCOOrderReport struct {
 	Symbol                   string          `json:"symbol"`
 	OrderID                  int64           `json:"orderId"`
@@ -406,6 +447,598 @@ type OCOOrderReport struct {
 	IcebergQuantity          string          `json:"icebergQty"`
 }
 
+// CreateOTOService create an OTO order list, the pending order is placed once the working order is fully filled
+type CreateOTOService struct {
+	c                       *Client
+	symbol                  string
+	listClientOrderID       *string
+	newOrderRespType        *NewOrderRespType
+	selfTradePreventionMode *SelfTradePreventionMode
+	workingType             OrderType
+	workingSide             SideType
+	workingClientOrderID    *string
+	workingPrice            string
+	workingQuantity         string
+	workingIcebergQuantity  *string
+	workingTimeInForce      *TimeInForceType
+	workingStrategyID       *int64
+	workingStrategyType     *int64
+	pendingType             OrderType
+	pendingSide             SideType
+	pendingClientOrderID    *string
+	pendingPrice            *string
+	pendingStopPrice        *string
+	pendingTrailingDelta    *int64
+	pendingQuantity         string
+	pendingIcebergQuantity  *string
+	pendingTimeInForce      *TimeInForceType
+	pendingStrategyID       *int64
+	pendingStrategyType     *int64
+}
+
+// Symbol set symbol
+func (s *CreateOTOService) Symbol(symbol string) *CreateOTOService {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderId
+func (s *CreateOTOService) ListClientOrderID(listClientOrderID string) *CreateOTOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOTOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOTOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateOTOService) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) *CreateOTOService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// WorkingType set workingType
+func (s *CreateOTOService) WorkingType(workingType OrderType) *CreateOTOService {
+	s.workingType = workingType
+	return s
+}
+
+// WorkingSide set workingSide
+func (s *CreateOTOService) WorkingSide(workingSide SideType) *CreateOTOService {
+	s.workingSide = workingSide
+	return s
+}
+
+// WorkingClientOrderID set workingClientOrderId
+func (s *CreateOTOService) WorkingClientOrderID(workingClientOrderID string) *CreateOTOService {
+	s.workingClientOrderID = &workingClientOrderID
+	return s
+}
+
+// WorkingPrice set workingPrice
+func (s *CreateOTOService) WorkingPrice(workingPrice string) *CreateOTOService {
+	s.workingPrice = workingPrice
+	return s
+}
+
+// WorkingQuantity set workingQuantity
+func (s *CreateOTOService) WorkingQuantity(workingQuantity string) *CreateOTOService {
+	s.workingQuantity = workingQuantity
+	return s
+}
+
+// WorkingIcebergQuantity set workingIcebergQty
+func (s *CreateOTOService) WorkingIcebergQuantity(workingIcebergQuantity string) *CreateOTOService {
+	s.workingIcebergQuantity = &workingIcebergQuantity
+	return s
+}
+
+// WorkingTimeInForce set workingTimeInForce
+func (s *CreateOTOService) WorkingTimeInForce(workingTimeInForce TimeInForceType) *CreateOTOService {
+	s.workingTimeInForce = &workingTimeInForce
+	return s
+}
+
+// WorkingStrategyID set workingStrategyId
+func (s *CreateOTOService) WorkingStrategyID(workingStrategyID int64) *CreateOTOService {
+	s.workingStrategyID = &workingStrategyID
+	return s
+}
+
+// WorkingStrategyType set workingStrategyType
+func (s *CreateOTOService) WorkingStrategyType(workingStrategyType int64) *CreateOTOService {
+	s.workingStrategyType = &workingStrategyType
+	return s
+}
+
+// PendingType set pendingType
+func (s *CreateOTOService) PendingType(pendingType OrderType) *CreateOTOService {
+	s.pendingType = pendingType
+	return s
+}
+
+// PendingSide set pendingSide
+func (s *CreateOTOService) PendingSide(pendingSide SideType) *CreateOTOService {
+	s.pendingSide = pendingSide
+	return s
+}
+
+// PendingClientOrderID set pendingClientOrderId
+func (s *CreateOTOService) PendingClientOrderID(pendingClientOrderID string) *CreateOTOService {
+	s.pendingClientOrderID = &pendingClientOrderID
+	return s
+}
+
+// PendingPrice set pendingPrice
+func (s *CreateOTOService) PendingPrice(pendingPrice string) *CreateOTOService {
+	s.pendingPrice = &pendingPrice
+	return s
+}
+
+// PendingStopPrice set pendingStopPrice
+func (s *CreateOTOService) PendingStopPrice(pendingStopPrice string) *CreateOTOService {
+	s.pendingStopPrice = &pendingStopPrice
+	return s
+}
+
+// PendingTrailingDelta set pendingTrailingDelta
+func (s *CreateOTOService) PendingTrailingDelta(pendingTrailingDelta int64) *CreateOTOService {
+	s.pendingTrailingDelta = &pendingTrailingDelta
+	return s
+}
+
+// PendingQuantity set pendingQuantity
+func (s *CreateOTOService) PendingQuantity(pendingQuantity string) *CreateOTOService {
+	s.pendingQuantity = pendingQuantity
+	return s
+}
+
+// PendingIcebergQuantity set pendingIcebergQty
+func (s *CreateOTOService) PendingIcebergQuantity(pendingIcebergQuantity string) *CreateOTOService {
+	s.pendingIcebergQuantity = &pendingIcebergQuantity
+	return s
+}
+
+// PendingTimeInForce set pendingTimeInForce
+func (s *CreateOTOService) PendingTimeInForce(pendingTimeInForce TimeInForceType) *CreateOTOService {
+	s.pendingTimeInForce = &pendingTimeInForce
+	return s
+}
+
+// PendingStrategyID set pendingStrategyId
+func (s *CreateOTOService) PendingStrategyID(pendingStrategyID int64) *CreateOTOService {
+	s.pendingStrategyID = &pendingStrategyID
+	return s
+}
+
+// PendingStrategyType set pendingStrategyType
+func (s *CreateOTOService) PendingStrategyType(pendingStrategyType int64) *CreateOTOService {
+	s.pendingStrategyType = &pendingStrategyType
+	return s
+}
+
+// Do send request
+func (s *CreateOTOService) Do(ctx context.Context, opts ...RequestOption) (res *OrderListResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v3/orderList/oto",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":          s.symbol,
+		"workingType":     s.workingType,
+		"workingSide":     s.workingSide,
+		"workingPrice":    s.workingPrice,
+		"workingQuantity": s.workingQuantity,
+		"pendingType":     s.pendingType,
+		"pendingSide":     s.pendingSide,
+		"pendingQuantity": s.pendingQuantity,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.workingClientOrderID != nil {
+		m["workingClientOrderId"] = *s.workingClientOrderID
+	}
+	if s.workingIcebergQuantity != nil {
+		m["workingIcebergQty"] = *s.workingIcebergQuantity
+	}
+	if s.workingTimeInForce != nil {
+		m["workingTimeInForce"] = *s.workingTimeInForce
+	}
+	if s.workingStrategyID != nil {
+		m["workingStrategyId"] = *s.workingStrategyID
+	}
+	if s.workingStrategyType != nil {
+		m["workingStrategyType"] = *s.workingStrategyType
+	}
+	if s.pendingClientOrderID != nil {
+		m["pendingClientOrderId"] = *s.pendingClientOrderID
+	}
+	if s.pendingPrice != nil {
+		m["pendingPrice"] = *s.pendingPrice
+	}
+	if s.pendingStopPrice != nil {
+		m["pendingStopPrice"] = *s.pendingStopPrice
+	}
+	if s.pendingTrailingDelta != nil {
+		m["pendingTrailingDelta"] = *s.pendingTrailingDelta
+	}
+	if s.pendingIcebergQuantity != nil {
+		m["pendingIcebergQty"] = *s.pendingIcebergQuantity
+	}
+	if s.pendingTimeInForce != nil {
+		m["pendingTimeInForce"] = *s.pendingTimeInForce
+	}
+	if s.pendingStrategyID != nil {
+		m["pendingStrategyId"] = *s.pendingStrategyID
+	}
+	if s.pendingStrategyType != nil {
+		m["pendingStrategyType"] = *s.pendingStrategyType
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OrderListResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateOTOCOService create an OTOCO order list, the pending OCO pair above and below is placed once the working order is fully filled
+type CreateOTOCOService struct {
+	c                           *Client
+	symbol                      string
+	listClientOrderID           *string
+	newOrderRespType            *NewOrderRespType
+	selfTradePreventionMode     *SelfTradePreventionMode
+	workingType                 OrderType
+	workingSide                 SideType
+	workingClientOrderID        *string
+	workingPrice                string
+	workingQuantity             string
+	workingIcebergQuantity      *string
+	workingTimeInForce          *TimeInForceType
+	workingStrategyID           *int64
+	workingStrategyType         *int64
+	pendingSide                 SideType
+	pendingQuantity             string
+	pendingAboveType            OrderType
+	pendingAboveClientOrderID   *string
+	pendingAbovePrice           *string
+	pendingAboveStopPrice       *string
+	pendingAboveTrailingDelta   *int64
+	pendingAboveIcebergQuantity *string
+	pendingAboveTimeInForce     *TimeInForceType
+	pendingAboveStrategyID      *int64
+	pendingAboveStrategyType    *int64
+	pendingBelowType            *OrderType
+	pendingBelowClientOrderID   *string
+	pendingBelowPrice           *string
+	pendingBelowStopPrice       *string
+	pendingBelowTrailingDelta   *int64
+	pendingBelowIcebergQuantity *string
+	pendingBelowTimeInForce     *TimeInForceType
+	pendingBelowStrategyID      *int64
+	pendingBelowStrategyType    *int64
+}
+
+// Symbol set symbol
+func (s *CreateOTOCOService) Symbol(symbol string) *CreateOTOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderId
+func (s *CreateOTOCOService) ListClientOrderID(listClientOrderID string) *CreateOTOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOTOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOTOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateOTOCOService) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) *CreateOTOCOService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// WorkingType set workingType
+func (s *CreateOTOCOService) WorkingType(workingType OrderType) *CreateOTOCOService {
+	s.workingType = workingType
+	return s
+}
+
+// WorkingSide set workingSide
+func (s *CreateOTOCOService) WorkingSide(workingSide SideType) *CreateOTOCOService {
+	s.workingSide = workingSide
+	return s
+}
+
+// WorkingClientOrderID set workingClientOrderId
+func (s *CreateOTOCOService) WorkingClientOrderID(workingClientOrderID string) *CreateOTOCOService {
+	s.workingClientOrderID = &workingClientOrderID
+	return s
+}
+
+// WorkingPrice set workingPrice
+func (s *CreateOTOCOService) WorkingPrice(workingPrice string) *CreateOTOCOService {
+	s.workingPrice = workingPrice
+	return s
+}
+
+// WorkingQuantity set workingQuantity
+func (s *CreateOTOCOService) WorkingQuantity(workingQuantity string) *CreateOTOCOService {
+	s.workingQuantity = workingQuantity
+	return s
+}
+
+// WorkingIcebergQuantity set workingIcebergQty
+func (s *CreateOTOCOService) WorkingIcebergQuantity(workingIcebergQuantity string) *CreateOTOCOService {
+	s.workingIcebergQuantity = &workingIcebergQuantity
+	return s
+}
+
+// WorkingTimeInForce set workingTimeInForce
+func (s *CreateOTOCOService) WorkingTimeInForce(workingTimeInForce TimeInForceType) *CreateOTOCOService {
+	s.workingTimeInForce = &workingTimeInForce
+	return s
+}
+
+// WorkingStrategyID set workingStrategyId
+func (s *CreateOTOCOService) WorkingStrategyID(workingStrategyID int64) *CreateOTOCOService {
+	s.workingStrategyID = &workingStrategyID
+	return s
+}
+
+// WorkingStrategyType set workingStrategyType
+func (s *CreateOTOCOService) WorkingStrategyType(workingStrategyType int64) *CreateOTOCOService {
+	s.workingStrategyType = &workingStrategyType
+	return s
+}
+
+// PendingSide set pendingSide
+func (s *CreateOTOCOService) PendingSide(pendingSide SideType) *CreateOTOCOService {
+	s.pendingSide = pendingSide
+	return s
+}
+
+// PendingQuantity set pendingQuantity
+func (s *CreateOTOCOService) PendingQuantity(pendingQuantity string) *CreateOTOCOService {
+	s.pendingQuantity = pendingQuantity
+	return s
+}
+
+// PendingAboveType set pendingAboveType
+func (s *CreateOTOCOService) PendingAboveType(pendingAboveType OrderType) *CreateOTOCOService {
+	s.pendingAboveType = pendingAboveType
+	return s
+}
+
+// PendingAboveClientOrderID set pendingAboveClientOrderId
+func (s *CreateOTOCOService) PendingAboveClientOrderID(pendingAboveClientOrderID string) *CreateOTOCOService {
+	s.pendingAboveClientOrderID = &pendingAboveClientOrderID
+	return s
+}
+
+// PendingAbovePrice set pendingAbovePrice
+func (s *CreateOTOCOService) PendingAbovePrice(pendingAbovePrice string) *CreateOTOCOService {
+	s.pendingAbovePrice = &pendingAbovePrice
+	return s
+}
+
+// PendingAboveStopPrice set pendingAboveStopPrice
+func (s *CreateOTOCOService) PendingAboveStopPrice(pendingAboveStopPrice string) *CreateOTOCOService {
+	s.pendingAboveStopPrice = &pendingAboveStopPrice
+	return s
+}
+
+// PendingAboveTrailingDelta set pendingAboveTrailingDelta
+func (s *CreateOTOCOService) PendingAboveTrailingDelta(pendingAboveTrailingDelta int64) *CreateOTOCOService {
+	s.pendingAboveTrailingDelta = &pendingAboveTrailingDelta
+	return s
+}
+
+// PendingAboveIcebergQuantity set pendingAboveIcebergQty
+func (s *CreateOTOCOService) PendingAboveIcebergQuantity(pendingAboveIcebergQuantity string) *CreateOTOCOService {
+	s.pendingAboveIcebergQuantity = &pendingAboveIcebergQuantity
+	return s
+}
+
+// PendingAboveTimeInForce set pendingAboveTimeInForce
+func (s *CreateOTOCOService) PendingAboveTimeInForce(pendingAboveTimeInForce TimeInForceType) *CreateOTOCOService {
+	s.pendingAboveTimeInForce = &pendingAboveTimeInForce
+	return s
+}
+
+// PendingAboveStrategyID set pendingAboveStrategyId
+func (s *CreateOTOCOService) PendingAboveStrategyID(pendingAboveStrategyID int64) *CreateOTOCOService {
+	s.pendingAboveStrategyID = &pendingAboveStrategyID
+	return s
+}
+
+// PendingAboveStrategyType set pendingAboveStrategyType
+func (s *CreateOTOCOService) PendingAboveStrategyType(pendingAboveStrategyType int64) *CreateOTOCOService {
+	s.pendingAboveStrategyType = &pendingAboveStrategyType
+	return s
+}
+
+// PendingBelowType set pendingBelowType
+func (s *CreateOTOCOService) PendingBelowType(pendingBelowType OrderType) *CreateOTOCOService {
+	s.pendingBelowType = &pendingBelowType
+	return s
+}
+
+// PendingBelowClientOrderID set pendingBelowClientOrderId
+func (s *CreateOTOCOService) PendingBelowClientOrderID(pendingBelowClientOrderID string) *CreateOTOCOService {
+	s.pendingBelowClientOrderID = &pendingBelowClientOrderID
+	return s
+}
+
+// PendingBelowPrice set pendingBelowPrice
+func (s *CreateOTOCOService) PendingBelowPrice(pendingBelowPrice string) *CreateOTOCOService {
+	s.pendingBelowPrice = &pendingBelowPrice
+	return s
+}
+
+// PendingBelowStopPrice set pendingBelowStopPrice
+func (s *CreateOTOCOService) PendingBelowStopPrice(pendingBelowStopPrice string) *CreateOTOCOService {
+	s.pendingBelowStopPrice = &pendingBelowStopPrice
+	return s
+}
+
+// PendingBelowTrailingDelta set pendingBelowTrailingDelta
+func (s *CreateOTOCOService) PendingBelowTrailingDelta(pendingBelowTrailingDelta int64) *CreateOTOCOService {
+	s.pendingBelowTrailingDelta = &pendingBelowTrailingDelta
+	return s
+}
+
+// PendingBelowIcebergQuantity set pendingBelowIcebergQty
+func (s *CreateOTOCOService) PendingBelowIcebergQuantity(pendingBelowIcebergQuantity string) *CreateOTOCOService {
+	s.pendingBelowIcebergQuantity = &pendingBelowIcebergQuantity
+	return s
+}
+
+// PendingBelowTimeInForce set pendingBelowTimeInForce
+func (s *CreateOTOCOService) PendingBelowTimeInForce(pendingBelowTimeInForce TimeInForceType) *CreateOTOCOService {
+	s.pendingBelowTimeInForce = &pendingBelowTimeInForce
+	return s
+}
+
+// PendingBelowStrategyID set pendingBelowStrategyId
+func (s *CreateOTOCOService) PendingBelowStrategyID(pendingBelowStrategyID int64) *CreateOTOCOService {
+	s.pendingBelowStrategyID = &pendingBelowStrategyID
+	return s
+}
+
+// PendingBelowStrategyType set pendingBelowStrategyType
+func (s *CreateOTOCOService) PendingBelowStrategyType(pendingBelowStrategyType int64) *CreateOTOCOService {
+	s.pendingBelowStrategyType = &pendingBelowStrategyType
+	return s
+}
+
+// Do send request
+func (s *CreateOTOCOService) Do(ctx context.Context, opts ...RequestOption) (res *OrderListResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v3/orderList/otoco",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":           s.symbol,
+		"workingType":      s.workingType,
+		"workingSide":      s.workingSide,
+		"workingPrice":     s.workingPrice,
+		"workingQuantity":  s.workingQuantity,
+		"pendingSide":      s.pendingSide,
+		"pendingQuantity":  s.pendingQuantity,
+		"pendingAboveType": s.pendingAboveType,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.workingClientOrderID != nil {
+		m["workingClientOrderId"] = *s.workingClientOrderID
+	}
+	if s.workingIcebergQuantity != nil {
+		m["workingIcebergQty"] = *s.workingIcebergQuantity
+	}
+	if s.workingTimeInForce != nil {
+		m["workingTimeInForce"] = *s.workingTimeInForce
+	}
+	if s.workingStrategyID != nil {
+		m["workingStrategyId"] = *s.workingStrategyID
+	}
+	if s.workingStrategyType != nil {
+		m["workingStrategyType"] = *s.workingStrategyType
+	}
+	if s.pendingAboveClientOrderID != nil {
+		m["pendingAboveClientOrderId"] = *s.pendingAboveClientOrderID
+	}
+	if s.pendingAbovePrice != nil {
+		m["pendingAbovePrice"] = *s.pendingAbovePrice
+	}
+	if s.pendingAboveStopPrice != nil {
+		m["pendingAboveStopPrice"] = *s.pendingAboveStopPrice
+	}
+	if s.pendingAboveTrailingDelta != nil {
+		m["pendingAboveTrailingDelta"] = *s.pendingAboveTrailingDelta
+	}
+	if s.pendingAboveIcebergQuantity != nil {
+		m["pendingAboveIcebergQty"] = *s.pendingAboveIcebergQuantity
+	}
+	if s.pendingAboveTimeInForce != nil {
+		m["pendingAboveTimeInForce"] = *s.pendingAboveTimeInForce
+	}
+	if s.pendingAboveStrategyID != nil {
+		m["pendingAboveStrategyId"] = *s.pendingAboveStrategyID
+	}
+	if s.pendingAboveStrategyType != nil {
+		m["pendingAboveStrategyType"] = *s.pendingAboveStrategyType
+	}
+	if s.pendingBelowType != nil {
+		m["pendingBelowType"] = *s.pendingBelowType
+	}
+	if s.pendingBelowClientOrderID != nil {
+		m["pendingBelowClientOrderId"] = *s.pendingBelowClientOrderID
+	}
+	if s.pendingBelowPrice != nil {
+		m["pendingBelowPrice"] = *s.pendingBelowPrice
+	}
+	if s.pendingBelowStopPrice != nil {
+		m["pendingBelowStopPrice"] = *s.pendingBelowStopPrice
+	}
+	if s.pendingBelowTrailingDelta != nil {
+		m["pendingBelowTrailingDelta"] = *s.pendingBelowTrailingDelta
+	}
+	if s.pendingBelowIcebergQuantity != nil {
+		m["pendingBelowIcebergQty"] = *s.pendingBelowIcebergQuantity
+	}
+	if s.pendingBelowTimeInForce != nil {
+		m["pendingBelowTimeInForce"] = *s.pendingBelowTimeInForce
+	}
+	if s.pendingBelowStrategyID != nil {
+		m["pendingBelowStrategyId"] = *s.pendingBelowStrategyID
+	}
+	if s.pendingBelowStrategyType != nil {
+		m["pendingBelowStrategyType"] = *s.pendingBelowStrategyType
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OrderListResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // ListOpenOcoService list opened oco
 type ListOpenOcoService struct {
 	c *Client
@@ -747,6 +1380,279 @@ func (s *CancelOCOService) Do(ctx context.Context, opts ...RequestOption) (res *
 	return res, nil
 }
 
+// CancelReplaceOrderService cancels an existing order and places a new order on the same symbol
+type CancelReplaceOrderService struct {
+	c                          *Client
+	symbol                     string
+	side                       SideType
+	orderType                  OrderType
+	cancelReplaceMode          CancelReplaceModeType
+	timeInForce                *TimeInForceType
+	quantity                   *string
+	quoteOrderQuantity         *string
+	price                      *string
+	cancelNewClientOrderID     *string
+	cancelOrigClientOrderID    *string
+	cancelOrderID              *int64
+	newClientOrderID           *string
+	strategyID                 *int64
+	strategyType               *int64
+	stopPrice                  *string
+	trailingDelta              *int64
+	icebergQuantity            *string
+	newOrderRespType           *NewOrderRespType
+	selfTradePreventionMode    *SelfTradePreventionMode
+	cancelRestrictions         *CancelRestrictionsType
+	orderRateLimitExceededMode *OrderRateLimitExceededModeType
+}
+
+// Symbol set symbol
+func (s *CancelReplaceOrderService) Symbol(symbol string) *CancelReplaceOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CancelReplaceOrderService) Side(side SideType) *CancelReplaceOrderService {
+	s.side = side
+	return s
+}
+
+// Type set type
+func (s *CancelReplaceOrderService) Type(orderType OrderType) *CancelReplaceOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// CancelReplaceMode set cancelReplaceMode
+func (s *CancelReplaceOrderService) CancelReplaceMode(cancelReplaceMode CancelReplaceModeType) *CancelReplaceOrderService {
+	s.cancelReplaceMode = cancelReplaceMode
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CancelReplaceOrderService) TimeInForce(timeInForce TimeInForceType) *CancelReplaceOrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CancelReplaceOrderService) Quantity(quantity string) *CancelReplaceOrderService {
+	s.quantity = &quantity
+	return s
+}
+
+// QuoteOrderQty set quoteOrderQty
+func (s *CancelReplaceOrderService) QuoteOrderQty(quoteOrderQty string) *CancelReplaceOrderService {
+	s.quoteOrderQuantity = &quoteOrderQty
+	return s
+}
+
+// Price set price
+func (s *CancelReplaceOrderService) Price(price string) *CancelReplaceOrderService {
+	s.price = &price
+	return s
+}
+
+// CancelNewClientOrderID set cancelNewClientOrderId
+func (s *CancelReplaceOrderService) CancelNewClientOrderID(cancelNewClientOrderID string) *CancelReplaceOrderService {
+	s.cancelNewClientOrderID = &cancelNewClientOrderID
+	return s
+}
+
+// CancelOrigClientOrderID set cancelOrigClientOrderId
+func (s *CancelReplaceOrderService) CancelOrigClientOrderID(cancelOrigClientOrderID string) *CancelReplaceOrderService {
+	s.cancelOrigClientOrderID = &cancelOrigClientOrderID
+	return s
+}
+
+// CancelOrderID set cancelOrderId
+func (s *CancelReplaceOrderService) CancelOrderID(cancelOrderID int64) *CancelReplaceOrderService {
+	s.cancelOrderID = &cancelOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderId
+func (s *CancelReplaceOrderService) NewClientOrderID(newClientOrderID string) *CancelReplaceOrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// StrategyID set strategyId
+func (s *CancelReplaceOrderService) StrategyID(strategyID int64) *CancelReplaceOrderService {
+	s.strategyID = &strategyID
+	return s
+}
+
+// StrategyType set strategyType
+func (s *CancelReplaceOrderService) StrategyType(strategyType int64) *CancelReplaceOrderService {
+	s.strategyType = &strategyType
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *CancelReplaceOrderService) StopPrice(stopPrice string) *CancelReplaceOrderService {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// TrailingDelta set trailingDelta
+func (s *CancelReplaceOrderService) TrailingDelta(trailingDelta int64) *CancelReplaceOrderService {
+	s.trailingDelta = &trailingDelta
+	return s
+}
+
+// IcebergQuantity set icebergQty
+func (s *CancelReplaceOrderService) IcebergQuantity(icebergQuantity string) *CancelReplaceOrderService {
+	s.icebergQuantity = &icebergQuantity
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CancelReplaceOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *CancelReplaceOrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CancelReplaceOrderService) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) *CancelReplaceOrderService {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// CancelRestrictions set cancelRestrictions
+func (s *CancelReplaceOrderService) CancelRestrictions(cancelRestrictions CancelRestrictionsType) *CancelReplaceOrderService {
+	s.cancelRestrictions = &cancelRestrictions
+	return s
+}
+
+// OrderRateLimitExceededMode set orderRateLimitExceededMode
+func (s *CancelReplaceOrderService) OrderRateLimitExceededMode(orderRateLimitExceededMode OrderRateLimitExceededModeType) *CancelReplaceOrderService {
+	s.orderRateLimitExceededMode = &orderRateLimitExceededMode
+	return s
+}
+
+// Do send request.
+// If the cancel or the new order failed, a *CancelReplaceOrderError is returned with the result of both steps in Data.
+func (s *CancelReplaceOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelReplaceOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v3/order/cancelReplace",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":            s.symbol,
+		"side":              s.side,
+		"type":              s.orderType,
+		"cancelReplaceMode": s.cancelReplaceMode,
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.quoteOrderQuantity != nil {
+		m["quoteOrderQty"] = *s.quoteOrderQuantity
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.cancelNewClientOrderID != nil {
+		m["cancelNewClientOrderId"] = *s.cancelNewClientOrderID
+	}
+	if s.cancelOrigClientOrderID != nil {
+		m["cancelOrigClientOrderId"] = *s.cancelOrigClientOrderID
+	}
+	if s.cancelOrderID != nil {
+		m["cancelOrderId"] = *s.cancelOrderID
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.strategyID != nil {
+		m["strategyId"] = *s.strategyID
+	}
+	if s.strategyType != nil {
+		m["strategyType"] = *s.strategyType
+	}
+	if s.stopPrice != nil {
+		m["stopPrice"] = *s.stopPrice
+	}
+	if s.trailingDelta != nil {
+		m["trailingDelta"] = *s.trailingDelta
+	}
+	if s.icebergQuantity != nil {
+		m["icebergQty"] = *s.icebergQuantity
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.cancelRestrictions != nil {
+		m["cancelRestrictions"] = *s.cancelRestrictions
+	}
+	if s.orderRateLimitExceededMode != nil {
+		m["orderRateLimitExceededMode"] = *s.orderRateLimitExceededMode
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		// a failed cancel replace returns the result of both steps along with the error
+		if apiErr, ok := err.(*common.APIError); ok && len(data) > 0 {
+			cancelReplaceErr := new(CancelReplaceOrderError)
+			if e := json.Unmarshal(data, cancelReplaceErr); e == nil && cancelReplaceErr.Data != nil {
+				cancelReplaceErr.APIError = *apiErr
+				return nil, cancelReplaceErr
+			}
+		}
+		return nil, err
+	}
+	res = new(CancelReplaceOrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelReplaceOrderResponse define cancel replace order response
+type CancelReplaceOrderResponse struct {
+	CancelResult     CancelReplaceResultType        `json:"cancelResult"`
+	NewOrderResult   CancelReplaceResultType        `json:"newOrderResult"`
+	CancelResponse   *CancelReplaceCancelResponse   `json:"cancelResponse"`
+	NewOrderResponse *CancelReplaceNewOrderResponse `json:"newOrderResponse"`
+}
+
+// CancelReplaceCancelResponse define the cancel part of a cancel replace,
+// Code and Message are set if the cancel failed
+type CancelReplaceCancelResponse struct {
+	CancelOrderResponse
+	Code    int64  `json:"code"`
+	Message string `json:"msg"`
+}
+
+// CancelReplaceNewOrderResponse define the new order part of a cancel replace,
+// Code and Message are set if the new order failed or was not attempted
+type CancelReplaceNewOrderResponse struct {
+	CreateOrderResponse
+	Code    int64  `json:"code"`
+	Message string `json:"msg"`
+}
+
+// CancelReplaceOrderError define cancel replace error, Data holds the result of the cancel and the new order
+type CancelReplaceOrderError struct {
+	common.APIError
+	Data *CancelReplaceOrderResponse `json:"data,omitempty"`
+}
+
+// Unwrap return the underlying API error
+func (e *CancelReplaceOrderError) Unwrap() error {
+	return &e.APIError
+}
+
 // CancelOpenOrdersService cancel all active orders on a symbol.
 type CancelOpenOrdersService struct {
 	c      *Client
@@ -771,8 +1677,13 @@ func (s *CancelOpenOrdersService) Do(ctx context.Context, opts ...RequestOption)
 	if err != nil {
 		return &CancelOpenOrdersResponse{}, err
 	}
+	return parseCancelOpenOrdersResponse(data)
+}
+
+// parseCancelOpenOrdersResponse splits the canceled orders and order lists
+func parseCancelOpenOrdersResponse(data []byte) (*CancelOpenOrdersResponse, error) {
 	rawMessages := make([]*json.RawMessage, 0)
-	err = json.Unmarshal(data, &rawMessages)
+	err := json.Unmarshal(data, &rawMessages)
 	if err != nil {
 		return &CancelOpenOrdersResponse{}, err
 	}
@@ -1,9 +1,12 @@
 package binance
 
 import (
+	"errors"
+	"net/http"
 	"strings"
 	"testing"
 
+	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -241,6 +244,56 @@ func (s *baseOrderTestSuite) assertFillEqual(e, a *Fill) {
 	r.Equal(e.Quantity, a.Quantity, "Quantity")
 }
 
+func (s *orderServiceTestSuite) TestCreateOrderTestCommissionRates() {
+	data := []byte(`{
+		"standardCommissionForOrder": {
+			"maker": "0.00000112",
+			"taker": "0.00000114"
+		},
+		"taxCommissionForOrder": {
+			"maker": "0.00000112",
+			"taker": "0.00000114"
+		},
+		"discount": {
+			"enabledForAccount": true,
+			"enabledForSymbol": true,
+			"discountAsset": "BNB",
+			"discount": "0.25000000"
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                 "LTCBTC",
+			"side":                   SideTypeBuy,
+			"type":                   OrderTypeLimit,
+			"timeInForce":            TimeInForceTypeGTC,
+			"quantity":               "10",
+			"price":                  "0.0001",
+			"newClientOrderId":       "myOrder1",
+			"computeCommissionRates": true,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("10").Price("0.0001").
+		NewClientOrderID("myOrder1").TestCommissionRates(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&OrderTestCommissionRates{
+		StandardCommissionForOrder: CommissionGroup{Maker: "0.00000112", Taker: "0.00000114"},
+		TaxCommissionForOrder:      CommissionGroup{Maker: "0.00000112", Taker: "0.00000114"},
+		Discount: DiscountInfo{
+			EnabledForAccount: true,
+			EnabledForSymbol:  true,
+			DiscountAsset:     "BNB",
+			Discount:          "0.25000000",
+		},
+	}, res)
+}
+
 func (s *orderServiceTestSuite) TestCreateOCO() {
 	data := []byte(`{
 		"orderListId": 0,
@@ -410,6 +463,27 @@ func (s *baseOrderTestSuite) assertCreateOCOResponseEqual(e, a *CreateOCORespons
 	}
 }
 
+func (s *baseOrderTestSuite) assertOrderListResponseEqual(e, a *OrderListResponse) {
+	r := s.r()
+	r.Equal(e.ContingencyType, a.ContingencyType, "ContingencyType")
+	r.Equal(e.ListClientOrderID, a.ListClientOrderID, "ListClientOrderID")
+	r.Equal(e.ListOrderStatus, a.ListOrderStatus, "ListOrderStatus")
+	r.Equal(e.ListStatusType, a.ListStatusType, "ListStatusType")
+	r.Equal(e.OrderListID, a.OrderListID, "OrderListID")
+	r.Equal(e.TransactionTime, a.TransactionTime, "TransactionTime")
+	r.Equal(e.Symbol, a.Symbol, "Symbol")
+
+	r.Len(a.OrderReports, len(e.OrderReports))
+	for idx, orderReport := range e.OrderReports {
+		s.assertOCOOrderReportEqual(orderReport, a.OrderReports[idx])
+	}
+
+	r.Len(a.Orders, len(e.Orders))
+	for idx, order := range e.Orders {
+		s.assertOCOOrderEqual(order, a.Orders[idx])
+	}
+}
+
 func (s *baseOrderTestSuite) assertOCOOrderReportEqual(e, a *OCOOrderReport) {
 	r := s.r()
 	r.Equal(e.ClientOrderID, a.ClientOrderID, "ClientOrderID")
@@ -432,6 +506,200 @@ func (s *baseOrderTestSuite) assertOCOOrderEqual(e, a *OCOOrder) {
 	r.Equal(e.OrderID, a.OrderID, "OrderID")
 	r.Equal(e.Symbol, a.Symbol, "Symbol")
 }
+func (s *orderServiceTestSuite) TestCreateOTO() {
+	data := []byte(`{
+		"orderListId": 0,
+		"contingencyType": "OTO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "yl2ERtcar1o25zcWtqVBTC",
+		"transactionTime": 1712289389158,
+		"symbol": "LTCBTC",
+		"orders": [
+			{
+				"symbol": "LTCBTC",
+				"orderId": 4,
+				"clientOrderId": "Bq17mn9fP6vyCn75Jw1xya"
+			},
+			{
+				"symbol": "LTCBTC",
+				"orderId": 5,
+				"clientOrderId": "arLFo0zGJVDE69cvGBaU0d"
+			}
+		],
+		"orderReports": [
+			{
+				"symbol": "LTCBTC",
+				"orderId": 4,
+				"orderListId": 0,
+				"clientOrderId": "Bq17mn9fP6vyCn75Jw1xya",
+				"transactTime": 1712289389158,
+				"price": "1.00000000",
+				"origQty": "1.00000000",
+				"executedQty": "0.00000000",
+				"cummulativeQuoteQty": "0.00000000",
+				"status": "NEW",
+				"timeInForce": "GTC",
+				"type": "LIMIT",
+				"side": "SELL"
+			},
+			{
+				"symbol": "LTCBTC",
+				"orderId": 5,
+				"orderListId": 0,
+				"clientOrderId": "arLFo0zGJVDE69cvGBaU0d",
+				"transactTime": 1712289389158,
+				"price": "0.00000000",
+				"origQty": "5.00000000",
+				"executedQty": "0.00000000",
+				"cummulativeQuoteQty": "0.00000000",
+				"status": "PENDING_NEW",
+				"timeInForce": "GTC",
+				"type": "MARKET",
+				"side": "BUY"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":             "LTCBTC",
+			"workingType":        OrderTypeLimit,
+			"workingSide":        SideTypeSell,
+			"workingPrice":       "1",
+			"workingQuantity":    "1",
+			"workingTimeInForce": TimeInForceTypeGTC,
+			"pendingType":        OrderTypeMarket,
+			"pendingSide":        SideTypeBuy,
+			"pendingQuantity":    "5",
+			"newOrderRespType":   NewOrderRespTypeFULL,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOTOService().
+		Symbol("LTCBTC").
+		WorkingType(OrderTypeLimit).
+		WorkingSide(SideTypeSell).
+		WorkingPrice("1").
+		WorkingQuantity("1").
+		WorkingTimeInForce(TimeInForceTypeGTC).
+		PendingType(OrderTypeMarket).
+		PendingSide(SideTypeBuy).
+		PendingQuantity("5").
+		NewOrderRespType(NewOrderRespTypeFULL).
+		Do(newContext())
+
+	s.r().NoError(err)
+	e := &OrderListResponse{
+		OrderListID:       0,
+		ContingencyType:   ContingencyTypeOTO,
+		ListStatusType:    ListStatusTypeExecStarted,
+		ListOrderStatus:   ListOrderStatusTypeExecuting,
+		ListClientOrderID: "yl2ERtcar1o25zcWtqVBTC",
+		TransactionTime:   1712289389158,
+		Symbol:            "LTCBTC",
+		Orders: []*OCOOrder{
+			{Symbol: "LTCBTC", OrderID: 4, ClientOrderID: "Bq17mn9fP6vyCn75Jw1xya"},
+			{Symbol: "LTCBTC", OrderID: 5, ClientOrderID: "arLFo0zGJVDE69cvGBaU0d"},
+		},
+		OrderReports: []*OCOOrderReport{
+			{
+				Symbol:                   "LTCBTC",
+				OrderID:                  4,
+				OrderListID:              0,
+				ClientOrderID:            "Bq17mn9fP6vyCn75Jw1xya",
+				Price:                    "1.00000000",
+				OrigQuantity:             "1.00000000",
+				ExecutedQuantity:         "0.00000000",
+				CummulativeQuoteQuantity: "0.00000000",
+				Status:                   OrderStatusTypeNew,
+				Type:                     OrderTypeLimit,
+				Side:                     SideTypeSell,
+			},
+			{
+				Symbol:                   "LTCBTC",
+				OrderID:                  5,
+				OrderListID:              0,
+				ClientOrderID:            "arLFo0zGJVDE69cvGBaU0d",
+				Price:                    "0.00000000",
+				OrigQuantity:             "5.00000000",
+				ExecutedQuantity:         "0.00000000",
+				CummulativeQuoteQuantity: "0.00000000",
+				Status:                   OrderStatusTypePendingNew,
+				Type:                     OrderTypeMarket,
+				Side:                     SideTypeBuy,
+			},
+		},
+	}
+	s.assertOrderListResponseEqual(e, res)
+}
+
+func (s *orderServiceTestSuite) TestCreateOTOCO() {
+	data := []byte(`{
+		"orderListId": 1,
+		"contingencyType": "OTO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "RumwQpBaDctlUu5jyG5rs0",
+		"transactionTime": 1712291372842,
+		"symbol": "LTCBTC",
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 6, "clientOrderId": "fM9Y4m23IFJVCQmIrlUmMK"},
+			{"symbol": "LTCBTC", "orderId": 7, "clientOrderId": "6pcQbFIzTXGZQ1e2MkGDq4"},
+			{"symbol": "LTCBTC", "orderId": 8, "clientOrderId": "r4JMv9cwAYYUwwBZfbussx"}
+		],
+		"orderReports": []
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  "LTCBTC",
+			"listClientOrderId":       "RumwQpBaDctlUu5jyG5rs0",
+			"workingType":             OrderTypeLimit,
+			"workingSide":             SideTypeBuy,
+			"workingPrice":            "1.5",
+			"workingQuantity":         "1",
+			"workingTimeInForce":      TimeInForceTypeGTC,
+			"pendingSide":             SideTypeSell,
+			"pendingQuantity":         "1",
+			"pendingAboveType":        OrderTypeLimitMaker,
+			"pendingAbovePrice":       "2",
+			"pendingBelowType":        OrderTypeStopLossLimit,
+			"pendingBelowPrice":       "1",
+			"pendingBelowStopPrice":   "1.1",
+			"pendingBelowTimeInForce": TimeInForceTypeGTC,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOTOCOService().
+		Symbol("LTCBTC").
+		ListClientOrderID("RumwQpBaDctlUu5jyG5rs0").
+		WorkingType(OrderTypeLimit).
+		WorkingSide(SideTypeBuy).
+		WorkingPrice("1.5").
+		WorkingQuantity("1").
+		WorkingTimeInForce(TimeInForceTypeGTC).
+		PendingSide(SideTypeSell).
+		PendingQuantity("1").
+		PendingAboveType(OrderTypeLimitMaker).
+		PendingAbovePrice("2").
+		PendingBelowType(OrderTypeStopLossLimit).
+		PendingBelowPrice("1").
+		PendingBelowStopPrice("1.1").
+		PendingBelowTimeInForce(TimeInForceTypeGTC).
+		Do(newContext())
+
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(1), res.OrderListID)
+	r.Equal(ContingencyTypeOTO, res.ContingencyType)
+	r.Equal("RumwQpBaDctlUu5jyG5rs0", res.ListClientOrderID)
+	r.Len(res.Orders, 3)
+	r.Equal(int64(8), res.Orders[2].OrderID)
+}
+
 func (s *orderServiceTestSuite) TestListOpenOco() {
 	data := []byte(`[
 		{
@@ -885,6 +1153,138 @@ func (s *orderServiceTestSuite) TestCancelOrder() {
 	s.assertCancelOrderResponseEqual(e, res)
 }
 
+func (s *orderServiceTestSuite) TestCancelReplaceOrder() {
+	data := []byte(`{
+		"cancelResult": "SUCCESS",
+		"newOrderResult": "SUCCESS",
+		"cancelResponse": {
+			"symbol": "BTCUSDT",
+			"origClientOrderId": "DnLo3vTAQcjha43lAZhZ0y",
+			"orderId": 9,
+			"orderListId": -1,
+			"clientOrderId": "osxN3JXAtJvKvCqGeMWMVR",
+			"price": "0.01000000",
+			"origQty": "0.000100",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "CANCELED",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL"
+		},
+		"newOrderResponse": {
+			"symbol": "BTCUSDT",
+			"orderId": 10,
+			"orderListId": -1,
+			"clientOrderId": "wOceeeOzNORyLiQfw7jd8S",
+			"transactTime": 1652928801803,
+			"price": "0.02000000",
+			"origQty": "0.040000",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "BUY"
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                     "BTCUSDT",
+			"side":                       SideTypeBuy,
+			"type":                       OrderTypeLimit,
+			"cancelReplaceMode":          CancelReplaceModeTypeStopOnFailure,
+			"timeInForce":                TimeInForceTypeGTC,
+			"quantity":                   "0.04",
+			"price":                      "0.02",
+			"cancelOrderId":              int64(9),
+			"cancelRestrictions":         CancelRestrictionsTypeOnlyNew,
+			"orderRateLimitExceededMode": OrderRateLimitExceededModeTypeCancelOnly,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelReplaceOrderService().Symbol("BTCUSDT").
+		Side(SideTypeBuy).Type(OrderTypeLimit).CancelReplaceMode(CancelReplaceModeTypeStopOnFailure).
+		TimeInForce(TimeInForceTypeGTC).Quantity("0.04").Price("0.02").CancelOrderID(9).
+		CancelRestrictions(CancelRestrictionsTypeOnlyNew).
+		OrderRateLimitExceededMode(OrderRateLimitExceededModeTypeCancelOnly).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(CancelReplaceResultTypeSuccess, res.CancelResult)
+	r.Equal(CancelReplaceResultTypeSuccess, res.NewOrderResult)
+	r.Equal(int64(9), res.CancelResponse.OrderID)
+	r.Equal(OrderStatusTypeCanceled, res.CancelResponse.Status)
+	r.Equal(int64(10), res.NewOrderResponse.OrderID)
+	r.Equal(OrderStatusTypeNew, res.NewOrderResponse.Status)
+	r.Equal("0.02000000", res.NewOrderResponse.Price)
+}
+
+func (s *orderServiceTestSuite) TestCancelReplaceOrder_PartialFailure() {
+	data := []byte(`{
+		"code": -2021,
+		"msg": "Order cancel-replace partially failed.",
+		"data": {
+			"cancelResult": "SUCCESS",
+			"newOrderResult": "FAILURE",
+			"cancelResponse": {
+				"symbol": "BTCUSDT",
+				"origClientOrderId": "86M8erehfExV8z2RC8Zo8k",
+				"orderId": 3,
+				"orderListId": -1,
+				"clientOrderId": "G1kLo6aDv2KGNTFcjfTSFq",
+				"status": "CANCELED",
+				"type": "LIMIT_MAKER",
+				"side": "SELL"
+			},
+			"newOrderResponse": {
+				"code": -2010,
+				"msg": "Order would immediately match and take."
+			}
+		}
+	}`)
+	s.mockDo(data, nil, http.StatusConflict)
+	defer s.assertDo()
+
+	res, err := s.client.NewCancelReplaceOrderService().Symbol("BTCUSDT").
+		Side(SideTypeBuy).Type(OrderTypeLimitMaker).CancelReplaceMode(CancelReplaceModeTypeAllowFailure).
+		Quantity("0.1").Price("0.001").CancelOrderID(3).Do(newContext())
+	r := s.r()
+	r.Nil(res)
+	var cancelReplaceErr *CancelReplaceOrderError
+	r.True(errors.As(err, &cancelReplaceErr))
+	r.Equal(int64(-2021), cancelReplaceErr.Code)
+	r.NotNil(cancelReplaceErr.Data)
+	r.Equal(CancelReplaceResultTypeSuccess, cancelReplaceErr.Data.CancelResult)
+	r.Equal(CancelReplaceResultTypeFailure, cancelReplaceErr.Data.NewOrderResult)
+	r.Equal(int64(3), cancelReplaceErr.Data.CancelResponse.OrderID)
+	r.Equal(int64(-2010), cancelReplaceErr.Data.NewOrderResponse.Code)
+	r.Equal("Order would immediately match and take.", cancelReplaceErr.Data.NewOrderResponse.Message)
+
+	var apiErr *common.APIError
+	r.True(errors.As(err, &apiErr))
+	r.Equal(int64(-2021), apiErr.Code)
+}
+
+func (s *orderServiceTestSuite) TestCancelReplaceOrder_Error() {
+	data := []byte(`{"code": -1102, "msg": "Mandatory parameter 'cancelReplaceMode' was not sent."}`)
+	s.mockDo(data, nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	res, err := s.client.NewCancelReplaceOrderService().Symbol("BTCUSDT").Do(newContext())
+	r := s.r()
+	r.Nil(res)
+	var cancelReplaceErr *CancelReplaceOrderError
+	r.False(errors.As(err, &cancelReplaceErr))
+	var apiErr *common.APIError
+	r.True(errors.As(err, &apiErr))
+	r.Equal(int64(-1102), apiErr.Code)
+}
+
 func (s *orderServiceTestSuite) TestCancelOpenOrders() {
 	data := []byte(`[
 		{
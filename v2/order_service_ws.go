@@ -0,0 +1,910 @@
+package binance
+
+import (
+	"context"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// OrderCancelWsService cancels an order
+type OrderCancelWsService struct {
+	c *WsApiClient
+}
+
+// OrderCancelWsRequest parameters for 'order.cancel' websocket API
+type OrderCancelWsRequest struct {
+	symbol             string
+	orderID            *int64
+	origClientOrderID  *string
+	newClientOrderID   *string
+	cancelRestrictions *CancelRestrictionsType
+	recvWindow         *uint16
+}
+
+// NewOrderCancelWsRequest init OrderCancelWsRequest
+func NewOrderCancelWsRequest() *OrderCancelWsRequest {
+	return &OrderCancelWsRequest{}
+}
+
+// Symbol set symbol
+func (s *OrderCancelWsRequest) Symbol(symbol string) *OrderCancelWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *OrderCancelWsRequest) OrderID(orderID int64) *OrderCancelWsRequest {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *OrderCancelWsRequest) OrigClientOrderID(origClientOrderID string) *OrderCancelWsRequest {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *OrderCancelWsRequest) NewClientOrderID(newClientOrderID string) *OrderCancelWsRequest {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// CancelRestrictions set cancelRestrictions
+func (s *OrderCancelWsRequest) CancelRestrictions(cancelRestrictions CancelRestrictionsType) *OrderCancelWsRequest {
+	s.cancelRestrictions = &cancelRestrictions
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OrderCancelWsRequest) RecvWindow(recvWindow uint16) *OrderCancelWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// buildParams builds params
+func (s *OrderCancelWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.cancelRestrictions != nil {
+		m["cancelRestrictions"] = *s.cancelRestrictions
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'order.cancel' request
+func (s *OrderCancelWsService) Do(requestID string, request *OrderCancelWsRequest) error {
+	return s.c.write(requestID, websocket.OrderCancelSpotWsApiMethod, request.buildParams(), true)
+}
+
+// SyncDo - sends 'order.cancel' request and receives response
+func (s *OrderCancelWsService) SyncDo(requestID string, request *OrderCancelWsRequest) (*CancelOrderWsResponse, error) {
+	return s.syncDo(s.c.writeSync, requestID, request)
+}
+
+// SyncDoContext - sends 'order.cancel' request and receives response until ctx is done
+func (s *OrderCancelWsService) SyncDoContext(ctx context.Context, requestID string, request *OrderCancelWsRequest) (*CancelOrderWsResponse, error) {
+	return s.syncDo(s.c.writeSyncContext(ctx), requestID, request)
+}
+
+func (s *OrderCancelWsService) syncDo(writeSync wsApiWriteSync, requestID string, request *OrderCancelWsRequest) (*CancelOrderWsResponse, error) {
+	res := &CancelOrderWsResponse{}
+	if err := writeSync(requestID, websocket.OrderCancelSpotWsApiMethod, request.buildParams(), true, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelOrderWsResponse define 'order.cancel' websocket API response
+type CancelOrderWsResponse struct {
+	Id     string              `json:"id"`
+	Status int                 `json:"status"`
+	Result CancelOrderResponse `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// OrderStatusWsService queries an order
+type OrderStatusWsService struct {
+	c *WsApiClient
+}
+
+// OrderStatusWsRequest parameters for 'order.status' websocket API
+type OrderStatusWsRequest struct {
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	recvWindow        *uint16
+}
+
+// NewOrderStatusWsRequest init OrderStatusWsRequest
+func NewOrderStatusWsRequest() *OrderStatusWsRequest {
+	return &OrderStatusWsRequest{}
+}
+
+// Symbol set symbol
+func (s *OrderStatusWsRequest) Symbol(symbol string) *OrderStatusWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *OrderStatusWsRequest) OrderID(orderID int64) *OrderStatusWsRequest {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *OrderStatusWsRequest) OrigClientOrderID(origClientOrderID string) *OrderStatusWsRequest {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OrderStatusWsRequest) RecvWindow(recvWindow uint16) *OrderStatusWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// buildParams builds params
+func (s *OrderStatusWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'order.status' request
+func (s *OrderStatusWsService) Do(requestID string, request *OrderStatusWsRequest) error {
+	return s.c.write(requestID, websocket.OrderStatusSpotWsApiMethod, request.buildParams(), true)
+}
+
+// SyncDo - sends 'order.status' request and receives response
+func (s *OrderStatusWsService) SyncDo(requestID string, request *OrderStatusWsRequest) (*OrderStatusWsResponse, error) {
+	return s.syncDo(s.c.writeSync, requestID, request)
+}
+
+// SyncDoContext - sends 'order.status' request and receives response until ctx is done
+func (s *OrderStatusWsService) SyncDoContext(ctx context.Context, requestID string, request *OrderStatusWsRequest) (*OrderStatusWsResponse, error) {
+	return s.syncDo(s.c.writeSyncContext(ctx), requestID, request)
+}
+
+func (s *OrderStatusWsService) syncDo(writeSync wsApiWriteSync, requestID string, request *OrderStatusWsRequest) (*OrderStatusWsResponse, error) {
+	res := &OrderStatusWsResponse{}
+	if err := writeSync(requestID, websocket.OrderStatusSpotWsApiMethod, request.buildParams(), true, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OrderStatusWsResponse define 'order.status' websocket API response
+type OrderStatusWsResponse struct {
+	Id     string `json:"id"`
+	Status int    `json:"status"`
+	Result Order  `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// OrderCancelReplaceWsService cancels an existing order and places a new order on the same symbol
+type OrderCancelReplaceWsService struct {
+	c *WsApiClient
+}
+
+// OrderCancelReplaceWsRequest parameters for 'order.cancelReplace' websocket API
+type OrderCancelReplaceWsRequest struct {
+	symbol                     string
+	cancelReplaceMode          CancelReplaceModeType
+	cancelOrderID              *int64
+	cancelOrigClientOrderID    *string
+	cancelNewClientOrderID     *string
+	side                       SideType
+	orderType                  OrderType
+	timeInForce                *TimeInForceType
+	quantity                   *string
+	quoteOrderQty              *string
+	price                      *string
+	newClientOrderID           *string
+	stopPrice                  *string
+	trailingDelta              *int64
+	icebergQty                 *string
+	newOrderRespType           *NewOrderRespType
+	selfTradePreventionMode    *SelfTradePreventionMode
+	cancelRestrictions         *CancelRestrictionsType
+	orderRateLimitExceededMode *OrderRateLimitExceededModeType
+	recvWindow                 *uint16
+}
+
+// NewOrderCancelReplaceWsRequest init OrderCancelReplaceWsRequest
+func NewOrderCancelReplaceWsRequest() *OrderCancelReplaceWsRequest {
+	return &OrderCancelReplaceWsRequest{}
+}
+
+// Symbol set symbol
+func (s *OrderCancelReplaceWsRequest) Symbol(symbol string) *OrderCancelReplaceWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// CancelReplaceMode set cancelReplaceMode
+func (s *OrderCancelReplaceWsRequest) CancelReplaceMode(cancelReplaceMode CancelReplaceModeType) *OrderCancelReplaceWsRequest {
+	s.cancelReplaceMode = cancelReplaceMode
+	return s
+}
+
+// CancelOrderID set cancelOrderId
+func (s *OrderCancelReplaceWsRequest) CancelOrderID(cancelOrderID int64) *OrderCancelReplaceWsRequest {
+	s.cancelOrderID = &cancelOrderID
+	return s
+}
+
+// CancelOrigClientOrderID set cancelOrigClientOrderId
+func (s *OrderCancelReplaceWsRequest) CancelOrigClientOrderID(cancelOrigClientOrderID string) *OrderCancelReplaceWsRequest {
+	s.cancelOrigClientOrderID = &cancelOrigClientOrderID
+	return s
+}
+
+// CancelNewClientOrderID set cancelNewClientOrderId
+func (s *OrderCancelReplaceWsRequest) CancelNewClientOrderID(cancelNewClientOrderID string) *OrderCancelReplaceWsRequest {
+	s.cancelNewClientOrderID = &cancelNewClientOrderID
+	return s
+}
+
+// Side set side
+func (s *OrderCancelReplaceWsRequest) Side(side SideType) *OrderCancelReplaceWsRequest {
+	s.side = side
+	return s
+}
+
+// Type set type
+func (s *OrderCancelReplaceWsRequest) Type(orderType OrderType) *OrderCancelReplaceWsRequest {
+	s.orderType = orderType
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *OrderCancelReplaceWsRequest) TimeInForce(timeInForce TimeInForceType) *OrderCancelReplaceWsRequest {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *OrderCancelReplaceWsRequest) Quantity(quantity string) *OrderCancelReplaceWsRequest {
+	s.quantity = &quantity
+	return s
+}
+
+// QuoteOrderQty set quoteOrderQty
+func (s *OrderCancelReplaceWsRequest) QuoteOrderQty(quoteOrderQty string) *OrderCancelReplaceWsRequest {
+	s.quoteOrderQty = &quoteOrderQty
+	return s
+}
+
+// Price set price
+func (s *OrderCancelReplaceWsRequest) Price(price string) *OrderCancelReplaceWsRequest {
+	s.price = &price
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *OrderCancelReplaceWsRequest) NewClientOrderID(newClientOrderID string) *OrderCancelReplaceWsRequest {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *OrderCancelReplaceWsRequest) StopPrice(stopPrice string) *OrderCancelReplaceWsRequest {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// TrailingDelta set trailingDelta
+func (s *OrderCancelReplaceWsRequest) TrailingDelta(trailingDelta int64) *OrderCancelReplaceWsRequest {
+	s.trailingDelta = &trailingDelta
+	return s
+}
+
+// IcebergQty set icebergQty
+func (s *OrderCancelReplaceWsRequest) IcebergQty(icebergQty string) *OrderCancelReplaceWsRequest {
+	s.icebergQty = &icebergQty
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *OrderCancelReplaceWsRequest) NewOrderRespType(newOrderRespType NewOrderRespType) *OrderCancelReplaceWsRequest {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *OrderCancelReplaceWsRequest) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) *OrderCancelReplaceWsRequest {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// CancelRestrictions set cancelRestrictions
+func (s *OrderCancelReplaceWsRequest) CancelRestrictions(cancelRestrictions CancelRestrictionsType) *OrderCancelReplaceWsRequest {
+	s.cancelRestrictions = &cancelRestrictions
+	return s
+}
+
+// OrderRateLimitExceededMode set orderRateLimitExceededMode
+func (s *OrderCancelReplaceWsRequest) OrderRateLimitExceededMode(orderRateLimitExceededMode OrderRateLimitExceededModeType) *OrderCancelReplaceWsRequest {
+	s.orderRateLimitExceededMode = &orderRateLimitExceededMode
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OrderCancelReplaceWsRequest) RecvWindow(recvWindow uint16) *OrderCancelReplaceWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// buildParams builds params
+func (s *OrderCancelReplaceWsRequest) buildParams() params {
+	m := params{
+		"symbol":            s.symbol,
+		"cancelReplaceMode": s.cancelReplaceMode,
+		"side":              s.side,
+		"type":              s.orderType,
+	}
+	if s.cancelOrderID != nil {
+		m["cancelOrderId"] = *s.cancelOrderID
+	}
+	if s.cancelOrigClientOrderID != nil {
+		m["cancelOrigClientOrderId"] = *s.cancelOrigClientOrderID
+	}
+	if s.cancelNewClientOrderID != nil {
+		m["cancelNewClientOrderId"] = *s.cancelNewClientOrderID
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.quoteOrderQty != nil {
+		m["quoteOrderQty"] = *s.quoteOrderQty
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.stopPrice != nil {
+		m["stopPrice"] = *s.stopPrice
+	}
+	if s.trailingDelta != nil {
+		m["trailingDelta"] = *s.trailingDelta
+	}
+	if s.icebergQty != nil {
+		m["icebergQty"] = *s.icebergQty
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.cancelRestrictions != nil {
+		m["cancelRestrictions"] = *s.cancelRestrictions
+	}
+	if s.orderRateLimitExceededMode != nil {
+		m["orderRateLimitExceededMode"] = *s.orderRateLimitExceededMode
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'order.cancelReplace' request
+func (s *OrderCancelReplaceWsService) Do(requestID string, request *OrderCancelReplaceWsRequest) error {
+	return s.c.write(requestID, websocket.OrderCancelReplaceSpotWsApiMethod, request.buildParams(), true)
+}
+
+// SyncDo - sends 'order.cancelReplace' request and receives response.
+// If the request partially failed, Error.Data holds the result of both the cancel and the new order.
+func (s *OrderCancelReplaceWsService) SyncDo(requestID string, request *OrderCancelReplaceWsRequest) (*CancelReplaceOrderWsResponse, error) {
+	return s.syncDo(s.c.writeSync, requestID, request)
+}
+
+// SyncDoContext - sends 'order.cancelReplace' request and receives response until ctx is done
+func (s *OrderCancelReplaceWsService) SyncDoContext(ctx context.Context, requestID string, request *OrderCancelReplaceWsRequest) (*CancelReplaceOrderWsResponse, error) {
+	return s.syncDo(s.c.writeSyncContext(ctx), requestID, request)
+}
+
+func (s *OrderCancelReplaceWsService) syncDo(writeSync wsApiWriteSync, requestID string, request *OrderCancelReplaceWsRequest) (*CancelReplaceOrderWsResponse, error) {
+	res := &CancelReplaceOrderWsResponse{}
+	if err := writeSync(requestID, websocket.OrderCancelReplaceSpotWsApiMethod, request.buildParams(), true, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelReplaceOrderWsResponse define 'order.cancelReplace' websocket API response
+type CancelReplaceOrderWsResponse struct {
+	Id     string                     `json:"id"`
+	Status int                        `json:"status"`
+	Result CancelReplaceOrderResponse `json:"result"`
+
+	// error response
+	Error *CancelReplaceOrderError `json:"error,omitempty"`
+}
+
+// OpenOrdersStatusWsService queries the open orders
+type OpenOrdersStatusWsService struct {
+	c *WsApiClient
+}
+
+// OpenOrdersStatusWsRequest parameters for 'openOrders.status' websocket API
+type OpenOrdersStatusWsRequest struct {
+	symbol     *string
+	recvWindow *uint16
+}
+
+// NewOpenOrdersStatusWsRequest init OpenOrdersStatusWsRequest
+func NewOpenOrdersStatusWsRequest() *OpenOrdersStatusWsRequest {
+	return &OpenOrdersStatusWsRequest{}
+}
+
+// Symbol set symbol, open orders of all symbols are returned if not set
+func (s *OpenOrdersStatusWsRequest) Symbol(symbol string) *OpenOrdersStatusWsRequest {
+	s.symbol = &symbol
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OpenOrdersStatusWsRequest) RecvWindow(recvWindow uint16) *OpenOrdersStatusWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// buildParams builds params
+func (s *OpenOrdersStatusWsRequest) buildParams() params {
+	m := params{}
+	if s.symbol != nil {
+		m["symbol"] = *s.symbol
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'openOrders.status' request
+func (s *OpenOrdersStatusWsService) Do(requestID string, request *OpenOrdersStatusWsRequest) error {
+	return s.c.write(requestID, websocket.OpenOrdersStatusSpotWsApiMethod, request.buildParams(), true)
+}
+
+// SyncDo - sends 'openOrders.status' request and receives response
+func (s *OpenOrdersStatusWsService) SyncDo(requestID string, request *OpenOrdersStatusWsRequest) (*OpenOrdersStatusWsResponse, error) {
+	return s.syncDo(s.c.writeSync, requestID, request)
+}
+
+// SyncDoContext - sends 'openOrders.status' request and receives response until ctx is done
+func (s *OpenOrdersStatusWsService) SyncDoContext(ctx context.Context, requestID string, request *OpenOrdersStatusWsRequest) (*OpenOrdersStatusWsResponse, error) {
+	return s.syncDo(s.c.writeSyncContext(ctx), requestID, request)
+}
+
+func (s *OpenOrdersStatusWsService) syncDo(writeSync wsApiWriteSync, requestID string, request *OpenOrdersStatusWsRequest) (*OpenOrdersStatusWsResponse, error) {
+	res := &OpenOrdersStatusWsResponse{}
+	if err := writeSync(requestID, websocket.OpenOrdersStatusSpotWsApiMethod, request.buildParams(), true, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OpenOrdersStatusWsResponse define 'openOrders.status' websocket API response
+type OpenOrdersStatusWsResponse struct {
+	Id     string   `json:"id"`
+	Status int      `json:"status"`
+	Result []*Order `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// OpenOrdersCancelAllWsService cancels all open orders on a symbol, including order lists
+type OpenOrdersCancelAllWsService struct {
+	c *WsApiClient
+}
+
+// OpenOrdersCancelAllWsRequest parameters for 'openOrders.cancelAll' websocket API
+type OpenOrdersCancelAllWsRequest struct {
+	symbol     string
+	recvWindow *uint16
+}
+
+// NewOpenOrdersCancelAllWsRequest init OpenOrdersCancelAllWsRequest
+func NewOpenOrdersCancelAllWsRequest() *OpenOrdersCancelAllWsRequest {
+	return &OpenOrdersCancelAllWsRequest{}
+}
+
+// Symbol set symbol
+func (s *OpenOrdersCancelAllWsRequest) Symbol(symbol string) *OpenOrdersCancelAllWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OpenOrdersCancelAllWsRequest) RecvWindow(recvWindow uint16) *OpenOrdersCancelAllWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// buildParams builds params
+func (s *OpenOrdersCancelAllWsRequest) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'openOrders.cancelAll' request
+func (s *OpenOrdersCancelAllWsService) Do(requestID string, request *OpenOrdersCancelAllWsRequest) error {
+	return s.c.write(requestID, websocket.OpenOrdersCancelAllSpotWsApiMethod, request.buildParams(), true)
+}
+
+// SyncDo - sends 'openOrders.cancelAll' request and receives response
+func (s *OpenOrdersCancelAllWsService) SyncDo(requestID string, request *OpenOrdersCancelAllWsRequest) (*CancelOpenOrdersWsResponse, error) {
+	return s.syncDo(s.c.writeSync, requestID, request)
+}
+
+// SyncDoContext - sends 'openOrders.cancelAll' request and receives response until ctx is done
+func (s *OpenOrdersCancelAllWsService) SyncDoContext(ctx context.Context, requestID string, request *OpenOrdersCancelAllWsRequest) (*CancelOpenOrdersWsResponse, error) {
+	return s.syncDo(s.c.writeSyncContext(ctx), requestID, request)
+}
+
+func (s *OpenOrdersCancelAllWsService) syncDo(writeSync wsApiWriteSync, requestID string, request *OpenOrdersCancelAllWsRequest) (*CancelOpenOrdersWsResponse, error) {
+	raw := &wsApiResponse{}
+	if err := writeSync(requestID, websocket.OpenOrdersCancelAllSpotWsApiMethod, request.buildParams(), true, raw); err != nil {
+		return nil, err
+	}
+	res := &CancelOpenOrdersWsResponse{
+		Id:     raw.Id,
+		Status: raw.Status,
+		Error:  raw.Error,
+	}
+	if raw.Error != nil {
+		return res, nil
+	}
+	result, err := parseCancelOpenOrdersResponse(raw.Result)
+	if err != nil {
+		return nil, err
+	}
+	res.Result = *result
+	return res, nil
+}
+
+// CancelOpenOrdersWsResponse define 'openOrders.cancelAll' websocket API response
+type CancelOpenOrdersWsResponse struct {
+	Id     string
+	Status int
+	Result CancelOpenOrdersResponse
+
+	// error response
+	Error *common.APIError
+}
+
+// OrderListPlaceOCOWsService places an OCO order list
+type OrderListPlaceOCOWsService struct {
+	c *WsApiClient
+}
+
+// OrderListPlaceOCOWsRequest parameters for 'orderList.place.oco' websocket API
+type OrderListPlaceOCOWsRequest struct {
+	symbol                  string
+	listClientOrderID       *string
+	side                    SideType
+	quantity                string
+	aboveType               OrderType
+	aboveClientOrderID      *string
+	aboveIcebergQty         *string
+	abovePrice              *string
+	aboveStopPrice          *string
+	aboveTrailingDelta      *int64
+	aboveTimeInForce        *TimeInForceType
+	aboveStrategyID         *int64
+	aboveStrategyType       *int64
+	belowType               OrderType
+	belowClientOrderID      *string
+	belowIcebergQty         *string
+	belowPrice              *string
+	belowStopPrice          *string
+	belowTrailingDelta      *int64
+	belowTimeInForce        *TimeInForceType
+	belowStrategyID         *int64
+	belowStrategyType       *int64
+	newOrderRespType        *NewOrderRespType
+	selfTradePreventionMode *SelfTradePreventionMode
+	recvWindow              *uint16
+}
+
+// NewOrderListPlaceOCOWsRequest init OrderListPlaceOCOWsRequest
+func NewOrderListPlaceOCOWsRequest() *OrderListPlaceOCOWsRequest {
+	return &OrderListPlaceOCOWsRequest{}
+}
+
+// Symbol set symbol
+func (s *OrderListPlaceOCOWsRequest) Symbol(symbol string) *OrderListPlaceOCOWsRequest {
+	s.symbol = symbol
+	return s
+}
+
+// ListClientOrderID set listClientOrderId
+func (s *OrderListPlaceOCOWsRequest) ListClientOrderID(listClientOrderID string) *OrderListPlaceOCOWsRequest {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// Side set side
+func (s *OrderListPlaceOCOWsRequest) Side(side SideType) *OrderListPlaceOCOWsRequest {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *OrderListPlaceOCOWsRequest) Quantity(quantity string) *OrderListPlaceOCOWsRequest {
+	s.quantity = quantity
+	return s
+}
+
+// AboveType set aboveType
+func (s *OrderListPlaceOCOWsRequest) AboveType(aboveType OrderType) *OrderListPlaceOCOWsRequest {
+	s.aboveType = aboveType
+	return s
+}
+
+// AboveClientOrderID set aboveClientOrderId
+func (s *OrderListPlaceOCOWsRequest) AboveClientOrderID(aboveClientOrderID string) *OrderListPlaceOCOWsRequest {
+	s.aboveClientOrderID = &aboveClientOrderID
+	return s
+}
+
+// AboveIcebergQty set aboveIcebergQty
+func (s *OrderListPlaceOCOWsRequest) AboveIcebergQty(aboveIcebergQty string) *OrderListPlaceOCOWsRequest {
+	s.aboveIcebergQty = &aboveIcebergQty
+	return s
+}
+
+// AbovePrice set abovePrice
+func (s *OrderListPlaceOCOWsRequest) AbovePrice(abovePrice string) *OrderListPlaceOCOWsRequest {
+	s.abovePrice = &abovePrice
+	return s
+}
+
+// AboveStopPrice set aboveStopPrice
+func (s *OrderListPlaceOCOWsRequest) AboveStopPrice(aboveStopPrice string) *OrderListPlaceOCOWsRequest {
+	s.aboveStopPrice = &aboveStopPrice
+	return s
+}
+
+// AboveTrailingDelta set aboveTrailingDelta
+func (s *OrderListPlaceOCOWsRequest) AboveTrailingDelta(aboveTrailingDelta int64) *OrderListPlaceOCOWsRequest {
+	s.aboveTrailingDelta = &aboveTrailingDelta
+	return s
+}
+
+// AboveTimeInForce set aboveTimeInForce
+func (s *OrderListPlaceOCOWsRequest) AboveTimeInForce(aboveTimeInForce TimeInForceType) *OrderListPlaceOCOWsRequest {
+	s.aboveTimeInForce = &aboveTimeInForce
+	return s
+}
+
+// AboveStrategyID set aboveStrategyId
+func (s *OrderListPlaceOCOWsRequest) AboveStrategyID(aboveStrategyID int64) *OrderListPlaceOCOWsRequest {
+	s.aboveStrategyID = &aboveStrategyID
+	return s
+}
+
+// AboveStrategyType set aboveStrategyType
+func (s *OrderListPlaceOCOWsRequest) AboveStrategyType(aboveStrategyType int64) *OrderListPlaceOCOWsRequest {
+	s.aboveStrategyType = &aboveStrategyType
+	return s
+}
+
+// BelowType set belowType
+func (s *OrderListPlaceOCOWsRequest) BelowType(belowType OrderType) *OrderListPlaceOCOWsRequest {
+	s.belowType = belowType
+	return s
+}
+
+// BelowClientOrderID set belowClientOrderId
+func (s *OrderListPlaceOCOWsRequest) BelowClientOrderID(belowClientOrderID string) *OrderListPlaceOCOWsRequest {
+	s.belowClientOrderID = &belowClientOrderID
+	return s
+}
+
+// BelowIcebergQty set belowIcebergQty
+func (s *OrderListPlaceOCOWsRequest) BelowIcebergQty(belowIcebergQty string) *OrderListPlaceOCOWsRequest {
+	s.belowIcebergQty = &belowIcebergQty
+	return s
+}
+
+// BelowPrice set belowPrice
+func (s *OrderListPlaceOCOWsRequest) BelowPrice(belowPrice string) *OrderListPlaceOCOWsRequest {
+	s.belowPrice = &belowPrice
+	return s
+}
+
+// BelowStopPrice set belowStopPrice
+func (s *OrderListPlaceOCOWsRequest) BelowStopPrice(belowStopPrice string) *OrderListPlaceOCOWsRequest {
+	s.belowStopPrice = &belowStopPrice
+	return s
+}
+
+// BelowTrailingDelta set belowTrailingDelta
+func (s *OrderListPlaceOCOWsRequest) BelowTrailingDelta(belowTrailingDelta int64) *OrderListPlaceOCOWsRequest {
+	s.belowTrailingDelta = &belowTrailingDelta
+	return s
+}
+
+// BelowTimeInForce set belowTimeInForce
+func (s *OrderListPlaceOCOWsRequest) BelowTimeInForce(belowTimeInForce TimeInForceType) *OrderListPlaceOCOWsRequest {
+	s.belowTimeInForce = &belowTimeInForce
+	return s
+}
+
+// BelowStrategyID set belowStrategyId
+func (s *OrderListPlaceOCOWsRequest) BelowStrategyID(belowStrategyID int64) *OrderListPlaceOCOWsRequest {
+	s.belowStrategyID = &belowStrategyID
+	return s
+}
+
+// BelowStrategyType set belowStrategyType
+func (s *OrderListPlaceOCOWsRequest) BelowStrategyType(belowStrategyType int64) *OrderListPlaceOCOWsRequest {
+	s.belowStrategyType = &belowStrategyType
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *OrderListPlaceOCOWsRequest) NewOrderRespType(newOrderRespType NewOrderRespType) *OrderListPlaceOCOWsRequest {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *OrderListPlaceOCOWsRequest) SelfTradePreventionMode(selfTradePreventionMode SelfTradePreventionMode) *OrderListPlaceOCOWsRequest {
+	s.selfTradePreventionMode = &selfTradePreventionMode
+	return s
+}
+
+// RecvWindow set recvWindow
+func (s *OrderListPlaceOCOWsRequest) RecvWindow(recvWindow uint16) *OrderListPlaceOCOWsRequest {
+	s.recvWindow = &recvWindow
+	return s
+}
+
+// buildParams builds params
+func (s *OrderListPlaceOCOWsRequest) buildParams() params {
+	m := params{
+		"symbol":    s.symbol,
+		"side":      s.side,
+		"quantity":  s.quantity,
+		"aboveType": s.aboveType,
+		"belowType": s.belowType,
+	}
+	if s.listClientOrderID != nil {
+		m["listClientOrderId"] = *s.listClientOrderID
+	}
+	if s.aboveClientOrderID != nil {
+		m["aboveClientOrderId"] = *s.aboveClientOrderID
+	}
+	if s.aboveIcebergQty != nil {
+		m["aboveIcebergQty"] = *s.aboveIcebergQty
+	}
+	if s.abovePrice != nil {
+		m["abovePrice"] = *s.abovePrice
+	}
+	if s.aboveStopPrice != nil {
+		m["aboveStopPrice"] = *s.aboveStopPrice
+	}
+	if s.aboveTrailingDelta != nil {
+		m["aboveTrailingDelta"] = *s.aboveTrailingDelta
+	}
+	if s.aboveTimeInForce != nil {
+		m["aboveTimeInForce"] = *s.aboveTimeInForce
+	}
+	if s.aboveStrategyID != nil {
+		m["aboveStrategyId"] = *s.aboveStrategyID
+	}
+	if s.aboveStrategyType != nil {
+		m["aboveStrategyType"] = *s.aboveStrategyType
+	}
+	if s.belowClientOrderID != nil {
+		m["belowClientOrderId"] = *s.belowClientOrderID
+	}
+	if s.belowIcebergQty != nil {
+		m["belowIcebergQty"] = *s.belowIcebergQty
+	}
+	if s.belowPrice != nil {
+		m["belowPrice"] = *s.belowPrice
+	}
+	if s.belowStopPrice != nil {
+		m["belowStopPrice"] = *s.belowStopPrice
+	}
+	if s.belowTrailingDelta != nil {
+		m["belowTrailingDelta"] = *s.belowTrailingDelta
+	}
+	if s.belowTimeInForce != nil {
+		m["belowTimeInForce"] = *s.belowTimeInForce
+	}
+	if s.belowStrategyID != nil {
+		m["belowStrategyId"] = *s.belowStrategyID
+	}
+	if s.belowStrategyType != nil {
+		m["belowStrategyType"] = *s.belowStrategyType
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.recvWindow != nil {
+		m["recvWindow"] = *s.recvWindow
+	}
+	return m
+}
+
+// Do - sends 'orderList.place.oco' request
+func (s *OrderListPlaceOCOWsService) Do(requestID string, request *OrderListPlaceOCOWsRequest) error {
+	return s.c.write(requestID, websocket.OrderListPlaceOCOSpotWsApiMethod, request.buildParams(), true)
+}
+
+// SyncDo - sends 'orderList.place.oco' request and receives response
+func (s *OrderListPlaceOCOWsService) SyncDo(requestID string, request *OrderListPlaceOCOWsRequest) (*CreateOCOWsResponse, error) {
+	return s.syncDo(s.c.writeSync, requestID, request)
+}
+
+// SyncDoContext - sends 'orderList.place.oco' request and receives response until ctx is done
+func (s *OrderListPlaceOCOWsService) SyncDoContext(ctx context.Context, requestID string, request *OrderListPlaceOCOWsRequest) (*CreateOCOWsResponse, error) {
+	return s.syncDo(s.c.writeSyncContext(ctx), requestID, request)
+}
+
+func (s *OrderListPlaceOCOWsService) syncDo(writeSync wsApiWriteSync, requestID string, request *OrderListPlaceOCOWsRequest) (*CreateOCOWsResponse, error) {
+	res := &CreateOCOWsResponse{}
+	if err := writeSync(requestID, websocket.OrderListPlaceOCOSpotWsApiMethod, request.buildParams(), true, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateOCOWsResponse define 'orderList.place.oco' websocket API response
+type CreateOCOWsResponse struct {
+	Id     string            `json:"id"`
+	Status int               `json:"status"`
+	Result CreateOCOResponse `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 // OrderCreateWsService creates order
 type OrderCreateWsService struct {
 	c          websocket.Client
+	session    *websocket.Session
 	ApiKey     string
 	SecretKey  string
 	KeyType    string
@@ -113,7 +115,7 @@ func (s *OrderCreateWsRequest) buildParams() params {
 
 // Do - sends 'order.place' request
 func (s *OrderCreateWsService) Do(requestID string, request *OrderCreateWsRequest) error {
-	rawData, err := websocket.CreateRequest(
+	rawData, err := s.session.CreateRequest(
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
@@ -137,7 +139,20 @@ func (s *OrderCreateWsService) Do(requestID string, request *OrderCreateWsReques
 
 // SyncDo - sends 'order.place' request and receives response
 func (s *OrderCreateWsService) SyncDo(requestID string, request *OrderCreateWsRequest) (*CreateOrderWsResponse, error) {
-	rawData, err := websocket.CreateRequest(
+	return s.syncDo(requestID, request, func(rawData []byte) ([]byte, error) {
+		return s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	})
+}
+
+// SyncDoContext - sends 'order.place' request and receives response until ctx is done
+func (s *OrderCreateWsService) SyncDoContext(ctx context.Context, requestID string, request *OrderCreateWsRequest) (*CreateOrderWsResponse, error) {
+	return s.syncDo(requestID, request, func(rawData []byte) ([]byte, error) {
+		return websocket.WriteSyncContext(ctx, s.c, requestID, rawData)
+	})
+}
+
+func (s *OrderCreateWsService) syncDo(requestID string, request *OrderCreateWsRequest, writeSync func(rawData []byte) ([]byte, error)) (*CreateOrderWsResponse, error) {
+	rawData, err := s.session.CreateRequest(
 		websocket.NewRequestData(
 			requestID,
 			s.ApiKey,
@@ -152,7 +167,7 @@ func (s *OrderCreateWsService) SyncDo(requestID string, request *OrderCreateWsRe
 		return nil, err
 	}
 
-	response, err := s.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	response, err := writeSync(rawData)
 	if err != nil {
 		return nil, err
 	}
@@ -285,6 +300,8 @@ type CreateOrderWsResponse struct {
 	Id     string            `json:"id"`
 	Status int               `json:"status"`
 	Result CreateOrderResult `json:"result"`
+	// RateLimits is the usage of the rate limits after the request
+	RateLimits []common.UsedRateLimit `json:"rateLimits,omitempty"`
 
 	// error response
 	Error *common.APIError `json:"error,omitempty"`
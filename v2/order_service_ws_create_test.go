@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -28,7 +29,7 @@ func (s *orderPlaceServiceWsTestSuite) SetupTest() {
 	s.newClientOrderID = "testOrder"
 
 	s.ctrl = gomock.NewController(s.T())
-	s.client = mock.NewMockClient(s.ctrl)
+	s.client = mock.NewMockContextClient(s.ctrl)
 
 	s.orderPlace = &OrderCreateWsService{
 		c:         s.client,
@@ -59,7 +60,7 @@ type orderPlaceServiceWsTestSuite struct {
 	timeOffset int64
 
 	ctrl   *gomock.Controller
-	client *mock.MockClient
+	client *mock.MockContextClient
 
 	requestID        string
 	symbol           string
@@ -156,6 +157,40 @@ func (s *orderPlaceServiceWsTestSuite) TestOrderPlaceSync() {
 	s.Equal(*req.price, response.Result.Price)
 }
 
+func (s *orderPlaceServiceWsTestSuite) TestOrderPlaceSyncContext() {
+	s.reset(s.apiKey, s.secretKey, s.signedKey, s.timeOffset)
+
+	orderPlaceResponse := CreateOrderWsResponse{
+		Id:     s.requestID,
+		Status: 200,
+		Result: CreateOrderResult{
+			CreateOrderResponse{
+				Symbol:        s.symbol,
+				OrderID:       0,
+				ClientOrderID: s.newClientOrderID,
+				Price:         s.price,
+				TimeInForce:   s.timeInForce,
+				Type:          s.orderType,
+				Side:          s.side,
+			},
+		},
+	}
+
+	rawResponseData, err := json.Marshal(orderPlaceResponse)
+	s.NoError(err)
+
+	ctx := context.Background()
+	s.client.EXPECT().WriteSyncContext(ctx, s.requestID, gomock.Any()).Return(rawResponseData, nil).Times(1)
+
+	req := s.orderPlaceRequest
+	response, err := s.orderPlace.SyncDoContext(ctx, s.requestID, req)
+	s.Require().NoError(err)
+	s.Equal(*req.newClientOrderID, response.Result.ClientOrderID)
+	s.Equal(req.symbol, response.Result.Symbol)
+	s.Equal(req.orderType, response.Result.Type)
+	s.Equal(*req.price, response.Result.Price)
+}
+
 func (s *orderPlaceServiceWsTestSuite) TestOrderPlaceSync_EmptyRequestID() {
 	s.reset(s.apiKey, s.secretKey, s.signedKey, s.timeOffset)
 
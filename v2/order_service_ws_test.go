@@ -0,0 +1,380 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderServiceWsTestSuite struct {
+	baseWsApiTestSuite
+}
+
+func TestOrderServiceWs(t *testing.T) {
+	suite.Run(t, new(orderServiceWsTestSuite))
+}
+
+func (s *orderServiceWsTestSuite) TestOrderCancel() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 200,
+		"result": {
+			"symbol": "BTCUSDT",
+			"origClientOrderId": "4d96324ff9d44481926157",
+			"orderId": 12569099453,
+			"orderListId": -1,
+			"clientOrderId": "91fe37ce9e69c90d6358c0",
+			"transactTime": 1684804350068,
+			"price": "23416.10000000",
+			"origQty": "0.00847000",
+			"executedQty": "0.00001000",
+			"cummulativeQuoteQty": "0.23416100",
+			"status": "CANCELED",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL",
+			"selfTradePreventionMode": "NONE"
+		}
+	}`)
+
+	res, err := s.wsClient.NewOrderCancelWsService().SyncDo(s.requestID, NewOrderCancelWsRequest().
+		Symbol("BTCUSDT").OrigClientOrderID("4d96324ff9d44481926157").
+		CancelRestrictions(CancelRestrictionsTypeOnlyNew))
+	s.Require().NoError(err)
+	s.Equal(websocket.OrderCancelSpotWsApiMethod, req.Method)
+	s.Equal("BTCUSDT", req.Params["symbol"])
+	s.Equal("4d96324ff9d44481926157", req.Params["origClientOrderId"])
+	s.Equal("ONLY_NEW", req.Params["cancelRestrictions"])
+	s.assertSigned(req)
+
+	s.Equal(200, res.Status)
+	s.Nil(res.Error)
+	s.Equal(CancelOrderResponse{
+		Symbol:                   "BTCUSDT",
+		OrigClientOrderID:        "4d96324ff9d44481926157",
+		OrderID:                  12569099453,
+		OrderListID:              -1,
+		ClientOrderID:            "91fe37ce9e69c90d6358c0",
+		TransactTime:             1684804350068,
+		Price:                    "23416.10000000",
+		OrigQuantity:             "0.00847000",
+		ExecutedQuantity:         "0.00001000",
+		CummulativeQuoteQuantity: "0.23416100",
+		Status:                   OrderStatusTypeCanceled,
+		TimeInForce:              TimeInForceTypeGTC,
+		Type:                     OrderTypeLimit,
+		Side:                     SideTypeSell,
+		SelfTradePreventionMode:  SelfTradePreventionModeNone,
+	}, res.Result)
+}
+
+func (s *orderServiceWsTestSuite) TestOrderCancel_APIError() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 400,
+		"error": {"code": -2011, "msg": "Unknown order sent."}
+	}`)
+
+	res, err := s.wsClient.NewOrderCancelWsService().SyncDo(s.requestID, NewOrderCancelWsRequest().
+		Symbol("BTCUSDT").OrderID(1))
+	s.Require().NoError(err)
+	s.Equal(400, res.Status)
+	s.Equal(&common.APIError{Code: -2011, Message: "Unknown order sent."}, res.Error)
+}
+
+func (s *orderServiceWsTestSuite) TestOrderStatus() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 200,
+		"result": {
+			"symbol": "BTCUSDT",
+			"orderId": 12569099453,
+			"orderListId": -1,
+			"clientOrderId": "4d96324ff9d44481926157",
+			"price": "23416.10000000",
+			"origQty": "0.00847000",
+			"executedQty": "0.00847000",
+			"cummulativeQuoteQty": "198.33521500",
+			"status": "FILLED",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL",
+			"stopPrice": "0.00000000",
+			"icebergQty": "0.00000000",
+			"time": 1660801715639,
+			"updateTime": 1660801717945,
+			"isWorking": true,
+			"origQuoteOrderQty": "0.00000000"
+		}
+	}`)
+
+	res, err := s.wsClient.NewOrderStatusWsService().SyncDo(s.requestID, NewOrderStatusWsRequest().
+		Symbol("BTCUSDT").OrderID(12569099453))
+	s.Require().NoError(err)
+	s.Equal(websocket.OrderStatusSpotWsApiMethod, req.Method)
+	s.Equal(float64(12569099453), req.Params["orderId"])
+	s.assertSigned(req)
+
+	s.Equal(int64(12569099453), res.Result.OrderID)
+	s.Equal(OrderStatusTypeFilled, res.Result.Status)
+	s.Equal("198.33521500", res.Result.CummulativeQuoteQuantity)
+	s.Equal(int64(1660801717945), res.Result.UpdateTime)
+}
+
+func (s *orderServiceWsTestSuite) TestOrderStatus_SyncDoContextCanceled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.client.EXPECT().WriteSyncContext(ctx, s.requestID, gomock.Any()).Return(nil, ctx.Err()).Times(1)
+
+	res, err := s.wsClient.NewOrderStatusWsService().SyncDoContext(ctx, s.requestID, NewOrderStatusWsRequest().
+		Symbol("BTCUSDT").OrderID(12569099453))
+	s.Require().ErrorIs(err, context.Canceled)
+	s.Nil(res)
+}
+
+func (s *orderServiceWsTestSuite) TestOrderCancelReplace() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 200,
+		"result": {
+			"cancelResult": "SUCCESS",
+			"newOrderResult": "SUCCESS",
+			"cancelResponse": {
+				"symbol": "BTCUSDT",
+				"origClientOrderId": "4d96324ff9d44481926157",
+				"orderId": 125690984230,
+				"orderListId": -1,
+				"clientOrderId": "91fe37ce9e69c90d6358c0",
+				"status": "CANCELED"
+			},
+			"newOrderResponse": {
+				"symbol": "BTCUSDT",
+				"orderId": 12569099453,
+				"clientOrderId": "bX5wROblo6YeDwa9iTLeyY",
+				"transactTime": 1660813156959,
+				"price": "23416.10000000",
+				"origQty": "0.00847000",
+				"status": "NEW",
+				"timeInForce": "GTC",
+				"type": "LIMIT",
+				"side": "SELL"
+			}
+		}
+	}`)
+
+	res, err := s.wsClient.NewOrderCancelReplaceWsService().SyncDo(s.requestID, NewOrderCancelReplaceWsRequest().
+		Symbol("BTCUSDT").
+		CancelReplaceMode(CancelReplaceModeTypeAllowFailure).
+		CancelOrigClientOrderID("4d96324ff9d44481926157").
+		Side(SideTypeSell).
+		Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).
+		Quantity("0.00847000").
+		Price("23416.10000000").
+		OrderRateLimitExceededMode(OrderRateLimitExceededModeTypeCancelOnly))
+	s.Require().NoError(err)
+	s.Equal(websocket.OrderCancelReplaceSpotWsApiMethod, req.Method)
+	s.Equal("ALLOW_FAILURE", req.Params["cancelReplaceMode"])
+	s.Equal("4d96324ff9d44481926157", req.Params["cancelOrigClientOrderId"])
+	s.Equal("CANCEL_ONLY", req.Params["orderRateLimitExceededMode"])
+	s.Equal("0.00847000", req.Params["quantity"])
+	s.assertSigned(req)
+
+	s.Nil(res.Error)
+	s.Equal(CancelReplaceResultTypeSuccess, res.Result.CancelResult)
+	s.Equal(CancelReplaceResultTypeSuccess, res.Result.NewOrderResult)
+	s.Equal(int64(125690984230), res.Result.CancelResponse.OrderID)
+	s.Equal(OrderStatusTypeCanceled, res.Result.CancelResponse.Status)
+	s.Equal(int64(12569099453), res.Result.NewOrderResponse.OrderID)
+	s.Equal(OrderStatusTypeNew, res.Result.NewOrderResponse.Status)
+}
+
+func (s *orderServiceWsTestSuite) TestOrderCancelReplace_PartialFailure() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 409,
+		"error": {
+			"code": -2021,
+			"msg": "Order cancel-replace partially failed.",
+			"data": {
+				"cancelResult": "SUCCESS",
+				"newOrderResult": "FAILURE",
+				"cancelResponse": {
+					"symbol": "BTCUSDT",
+					"orderId": 4,
+					"status": "CANCELED"
+				},
+				"newOrderResponse": {
+					"code": -2010,
+					"msg": "Order would immediately match and take."
+				}
+			}
+		}
+	}`)
+
+	res, err := s.wsClient.NewOrderCancelReplaceWsService().SyncDo(s.requestID, NewOrderCancelReplaceWsRequest().
+		Symbol("BTCUSDT").
+		CancelReplaceMode(CancelReplaceModeTypeAllowFailure).
+		CancelOrderID(4).
+		Side(SideTypeBuy).
+		Type(OrderTypeLimitMaker).
+		Quantity("1").
+		Price("30000"))
+	s.Require().NoError(err)
+	s.Equal(409, res.Status)
+	s.Require().NotNil(res.Error)
+	s.Equal(int64(-2021), res.Error.Code)
+
+	var apiErr *common.APIError
+	s.True(errors.As(res.Error, &apiErr))
+	s.Equal("Order cancel-replace partially failed.", apiErr.Message)
+
+	s.Require().NotNil(res.Error.Data)
+	s.Equal(CancelReplaceResultTypeSuccess, res.Error.Data.CancelResult)
+	s.Equal(CancelReplaceResultTypeFailure, res.Error.Data.NewOrderResult)
+	s.Equal(int64(4), res.Error.Data.CancelResponse.OrderID)
+	s.Equal(int64(-2010), res.Error.Data.NewOrderResponse.Code)
+	s.Equal("Order would immediately match and take.", res.Error.Data.NewOrderResponse.Message)
+}
+
+func (s *orderServiceWsTestSuite) TestOpenOrdersStatus() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 200,
+		"result": [
+			{"symbol": "BTCUSDT", "orderId": 1, "status": "NEW"},
+			{"symbol": "BTCUSDT", "orderId": 2, "status": "PARTIALLY_FILLED"}
+		]
+	}`)
+
+	res, err := s.wsClient.NewOpenOrdersStatusWsService().SyncDo(s.requestID, NewOpenOrdersStatusWsRequest().
+		Symbol("BTCUSDT"))
+	s.Require().NoError(err)
+	s.Equal(websocket.OpenOrdersStatusSpotWsApiMethod, req.Method)
+	s.Equal("BTCUSDT", req.Params["symbol"])
+	s.assertSigned(req)
+
+	s.Require().Len(res.Result, 2)
+	s.Equal(int64(1), res.Result[0].OrderID)
+	s.Equal(OrderStatusTypePartiallyFilled, res.Result[1].Status)
+}
+
+func (s *orderServiceWsTestSuite) TestOpenOrdersCancelAll() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 200,
+		"result": [
+			{
+				"symbol": "BTCUSDT",
+				"origClientOrderId": "4d96324ff9d44481926157",
+				"orderId": 12569099453,
+				"orderListId": -1,
+				"status": "CANCELED"
+			},
+			{
+				"orderListId": 19431,
+				"contingencyType": "OCO",
+				"listStatusType": "ALL_DONE",
+				"listOrderStatus": "ALL_DONE",
+				"listClientOrderId": "iuVNVJYYrByz6C4yGOPPK0",
+				"transactionTime": 1660803702431,
+				"symbol": "BTCUSDT",
+				"orders": [
+					{"symbol": "BTCUSDT", "orderId": 12569099453, "clientOrderId": "bX5wROblo6YeDwa9iTLeyY"},
+					{"symbol": "BTCUSDT", "orderId": 12569099454, "clientOrderId": "Tnu2IP0J5Y4mxw3IATBfmW"}
+				]
+			}
+		]
+	}`)
+
+	res, err := s.wsClient.NewOpenOrdersCancelAllWsService().SyncDo(s.requestID, NewOpenOrdersCancelAllWsRequest().
+		Symbol("BTCUSDT"))
+	s.Require().NoError(err)
+	s.Equal(websocket.OpenOrdersCancelAllSpotWsApiMethod, req.Method)
+	s.assertSigned(req)
+
+	s.Equal(s.requestID, res.Id)
+	s.Equal(200, res.Status)
+	s.Require().Len(res.Result.Orders, 1)
+	s.Equal(int64(12569099453), res.Result.Orders[0].OrderID)
+	s.Require().Len(res.Result.OCOOrders, 1)
+	s.Equal(int64(19431), res.Result.OCOOrders[0].OrderListID)
+	s.Len(res.Result.OCOOrders[0].Orders, 2)
+}
+
+func (s *orderServiceWsTestSuite) TestOpenOrdersCancelAll_APIError() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 400,
+		"error": {"code": -2011, "msg": "Unknown order sent."}
+	}`)
+
+	res, err := s.wsClient.NewOpenOrdersCancelAllWsService().SyncDo(s.requestID, NewOpenOrdersCancelAllWsRequest().
+		Symbol("BTCUSDT"))
+	s.Require().NoError(err)
+	s.Equal(400, res.Status)
+	s.Equal(int64(-2011), res.Error.Code)
+	s.Empty(res.Result.Orders)
+}
+
+func (s *orderServiceWsTestSuite) TestOrderListPlaceOCO() {
+	req := &websocket.WsApiRequest{}
+	s.expectWriteSync(req, `{
+		"id": "e2a85d9f-07a5-4f94-8d5f-789dc3deb098",
+		"status": 200,
+		"result": {
+			"orderListId": 1,
+			"contingencyType": "OCO",
+			"listStatusType": "EXEC_STARTED",
+			"listOrderStatus": "EXECUTING",
+			"listClientOrderId": "lH1YDkuQKWiXVXHPSKYEIp",
+			"transactionTime": 1710485608839,
+			"symbol": "LTCBTC",
+			"orders": [
+				{"symbol": "LTCBTC", "orderId": 10, "clientOrderId": "44nZvqpemY7sVYgPYbvPih"},
+				{"symbol": "LTCBTC", "orderId": 11, "clientOrderId": "NuMp0nVYnciDiFmVqfpBqK"}
+			],
+			"orderReports": [
+				{"symbol": "LTCBTC", "orderId": 10, "orderListId": 1, "type": "STOP_LOSS_LIMIT", "side": "SELL"},
+				{"symbol": "LTCBTC", "orderId": 11, "orderListId": 1, "type": "LIMIT_MAKER", "side": "SELL"}
+			]
+		}
+	}`)
+
+	res, err := s.wsClient.NewOrderListPlaceOCOWsService().SyncDo(s.requestID, NewOrderListPlaceOCOWsRequest().
+		Symbol("LTCBTC").
+		Side(SideTypeSell).
+		Quantity("1").
+		AboveType(OrderTypeLimitMaker).
+		AbovePrice("1.5").
+		BelowType(OrderTypeStopLossLimit).
+		BelowStopPrice("0.5").
+		BelowPrice("0.49").
+		BelowTimeInForce(TimeInForceTypeGTC))
+	s.Require().NoError(err)
+	s.Equal(websocket.OrderListPlaceOCOSpotWsApiMethod, req.Method)
+	s.Equal("LIMIT_MAKER", req.Params["aboveType"])
+	s.Equal("1.5", req.Params["abovePrice"])
+	s.Equal("STOP_LOSS_LIMIT", req.Params["belowType"])
+	s.Equal("0.5", req.Params["belowStopPrice"])
+	s.Equal("GTC", req.Params["belowTimeInForce"])
+	s.assertSigned(req)
+
+	s.Equal(int64(1), res.Result.OrderListID)
+	s.Equal("OCO", res.Result.ContingencyType)
+	s.Len(res.Result.Orders, 2)
+	s.Len(res.Result.OrderReports, 2)
+}
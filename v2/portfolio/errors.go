@@ -33,12 +33,19 @@ func (e Error) Error() string {
 	return e.APIError.Error()
 }
 
+// Unwrap returns the embedded APIError
+func (e *Error) Unwrap() error {
+	return &e.APIError
+}
+
 // IsPortfolioError check if e is a Portfolio error
 func IsPortfolioError(e error) bool {
 	_, ok := e.(*Error)
 	return ok
 }
 
+// The error codes are untyped to compare them with APIError.Code, see common.ErrorCode for the typed codes
+
 // 10xx - General Server or Network issues
 const (
 	ErrUnknown              = -1000 // An unknown error occurred while processing the request
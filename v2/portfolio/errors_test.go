@@ -0,0 +1,27 @@
+package portfolio
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+func TestErrorIs(t *testing.T) {
+	err := NewError(ErrMandatoryParamEmptyOrMalformed, "Mandatory parameter 'symbol' was not sent")
+	assert.True(t, errors.Is(err, common.ErrorCodeMandatoryParamEmptyOrMalformed))
+	assert.True(t, common.IsAPIError(err))
+	assert.True(t, IsPortfolioError(err))
+	assert.False(t, common.IsRetryable(err))
+}
+
+func TestErrorCodes(t *testing.T) {
+	// the codes are untyped constants, they can be compared with an int or an int64
+	var code int = ErrInvalidTimestamp
+	assert.Equal(t, -1021, code)
+	assert.Equal(t, int64(common.ErrorCodeInvalidTimestamp), int64(ErrInvalidTimestamp))
+	assert.Equal(t, int64(common.ErrorCodeMandatoryParamEmptyOrMalformed), int64(ErrMandatoryParamEmptyOrMalformed))
+	assert.Equal(t, int64(common.ErrorCodeNewOrderRejected), int64(ErrNewOrderRejected))
+}
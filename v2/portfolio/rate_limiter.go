@@ -0,0 +1,97 @@
+package portfolio
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// DefaultRateLimits define the documented default rate limits of the portfolio margin API.
+// The account specific ORDERS limits can be queried with GetRateLimitService.
+var DefaultRateLimits = []RateLimit{
+	{RateLimitType: common.RateLimitTypeRequestWeight, Interval: "MINUTE", IntervalNum: 1, Limit: 6000},
+	{RateLimitType: common.RateLimitTypeOrders, Interval: "MINUTE", IntervalNum: 1, Limit: 1200},
+}
+
+// NewRateLimiter init a client side rate limiter seeded with the given rate limits,
+// usually DefaultRateLimits. Assign it to Client.RateLimiter to enable it.
+func NewRateLimiter(rateLimits []RateLimit) *common.RateLimiter {
+	limits := make([]common.RateLimit, 0, len(rateLimits))
+	for _, rl := range rateLimits {
+		limits = append(limits, common.RateLimit(rl))
+	}
+	return common.NewRateLimiter(limits...)
+}
+
+// requestWeights define the REQUEST_WEIGHT of the /papi endpoints, the default weight is 1
+var requestWeights = map[string]int64{
+	http.MethodGet + " /papi/v1/account":                         20,
+	http.MethodGet + " /papi/v1/balance":                         20,
+	http.MethodGet + " /papi/v1/um/account":                      5,
+	http.MethodGet + " /papi/v2/um/account":                      5,
+	http.MethodGet + " /papi/v1/cm/account":                      5,
+	http.MethodGet + " /papi/v1/um/positionRisk":                 5,
+	http.MethodGet + " /papi/v1/um/allOrders":                    5,
+	http.MethodGet + " /papi/v1/cm/allOrders":                    20,
+	http.MethodGet + " /papi/v1/um/userTrades":                   5,
+	http.MethodGet + " /papi/v1/cm/userTrades":                   20,
+	http.MethodGet + " /papi/v1/um/income":                       30,
+	http.MethodGet + " /papi/v1/cm/income":                       30,
+	http.MethodGet + " /papi/v1/um/commissionRate":               20,
+	http.MethodGet + " /papi/v1/cm/commissionRate":               20,
+	http.MethodGet + " /papi/v1/um/forceOrders":                  20,
+	http.MethodGet + " /papi/v1/cm/forceOrders":                  20,
+	http.MethodGet + " /papi/v1/um/adlQuantile":                  5,
+	http.MethodGet + " /papi/v1/cm/adlQuantile":                  5,
+	http.MethodGet + " /papi/v1/um/orderAmendment":               1,
+	http.MethodGet + " /papi/v1/cm/orderAmendment":               1,
+	http.MethodGet + " /papi/v1/margin/allOrders":                100,
+	http.MethodGet + " /papi/v1/margin/myTrades":                 5,
+	http.MethodGet + " /papi/v1/margin/openOrders":               5,
+	http.MethodGet + " /papi/v1/margin/allOrderList":             100,
+	http.MethodGet + " /papi/v1/margin/forceOrders":              1,
+	http.MethodGet + " /papi/v1/margin/marginInterestHistory":    1,
+	http.MethodGet + " /papi/v1/rateLimit/order":                 1,
+	http.MethodPost + " /papi/v1/auto-collection":                750,
+	http.MethodPost + " /papi/v1/asset-collection":               30,
+	http.MethodPost + " /papi/v1/repay-futures-negative-balance": 750,
+	http.MethodPost + " /papi/v1/margin/repay-debt":              3000,
+	http.MethodPost + " /papi/v1/marginLoan":                     100,
+	http.MethodPost + " /papi/v1/repayLoan":                      100,
+	http.MethodPost + " /papi/v1/bnb-transfer":                   750,
+}
+
+// orderEndpoints define the endpoints counting against the ORDERS rate limits
+var orderEndpoints = map[string]int64{
+	http.MethodPost + " /papi/v1/um/order":             1,
+	http.MethodPut + " /papi/v1/um/order":              1,
+	http.MethodPost + " /papi/v1/um/conditional/order": 1,
+	http.MethodPost + " /papi/v1/cm/order":             1,
+	http.MethodPut + " /papi/v1/cm/order":              1,
+	http.MethodPost + " /papi/v1/cm/conditional/order": 1,
+	http.MethodPost + " /papi/v1/margin/order":         1,
+	http.MethodPost + " /papi/v1/margin/order/oco":     1,
+}
+
+// requestCost return the REQUEST_WEIGHT and ORDERS cost of the request
+func requestCost(r *request) (weight, orders int64) {
+	if !strings.HasPrefix(r.endpoint, "/papi/") {
+		return 0, 0
+	}
+	key := r.method + " " + r.endpoint
+	orders = orderEndpoints[key]
+	hasSymbol := r.query.Get("symbol") != ""
+	switch key {
+	case http.MethodGet + " /papi/v1/um/openOrders", http.MethodGet + " /papi/v1/cm/openOrders",
+		http.MethodGet + " /papi/v1/um/conditional/openOrders", http.MethodGet + " /papi/v1/cm/conditional/openOrders":
+		if hasSymbol {
+			return 1, orders
+		}
+		return 40, orders
+	}
+	if w, ok := requestWeights[key]; ok {
+		return w, orders
+	}
+	return 1, orders
+}
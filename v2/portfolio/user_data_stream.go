@@ -0,0 +1,51 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// UserDataStream keeps a user data stream connected: the listen key is kept alive and renewed,
+// and the stream is reconnected when the connection is lost, see OnGap to reconcile the missed events
+type UserDataStream = common.UserDataStream
+
+// NewUserDataStream init a user data stream, call Serve to connect
+func (c *Client) NewUserDataStream(handler WsUserDataHandler) *UserDataStream {
+	return common.NewUserDataStream(
+		func(ctx context.Context) (string, error) {
+			return c.NewStartUserStreamService().Do(ctx)
+		},
+		func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		func(ctx context.Context, listenKey string) error {
+			return c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		userDataStreamServe(handler))
+}
+
+// userDataStreamServe connect to the user data stream of a listen key, the stream reconnects
+// with a new listen key and reports the gap, WebsocketReconnectPolicy is not used
+func userDataStreamServe(handler WsUserDataHandler) common.UserDataServeFunc {
+	return func(listenKey string, expire func(), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		// the connection is replaced by the stream on listenKeyExpired, unlike WsUserDataServe it is not closed here
+		endpoint := fmt.Sprintf("%s/ws/%s", getWsEndpoint(), listenKey)
+		return wsConnect(newWsConfig(endpoint), wsUserDataHandler(&userDataStreamHandler{
+			WsUserDataHandler: handler,
+			expire:            expire,
+		}), errHandler)
+	}
+}
+
+// userDataStreamHandler renews the listen key of the stream on listenKeyExpired
+type userDataStreamHandler struct {
+	WsUserDataHandler
+	expire func()
+}
+
+func (h *userDataStreamHandler) HandleListenKeyExpired(event *WsListenKeyExpired) {
+	h.WsUserDataHandler.HandleListenKeyExpired(event)
+	h.expire()
+}
@@ -0,0 +1,139 @@
+package portfolio
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type userDataStreamTestSuite struct {
+	baseTestSuite
+	origWsConnect func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error)
+	conns         chan *userDataStreamTestConn
+}
+
+type userDataStreamTestConn struct {
+	endpoint string
+	handler  WsHandler
+	// drop closes the connection as if it was lost
+	drop func()
+}
+
+func TestUserDataStream(t *testing.T) {
+	suite.Run(t, new(userDataStreamTestSuite))
+}
+
+func (s *userDataStreamTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.origWsConnect = wsConnect
+	s.conns = make(chan *userDataStreamTestConn, 10)
+	wsConnect = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		doneC = make(chan struct{})
+		stopC = make(chan struct{})
+		dropC := make(chan struct{})
+		go func() {
+			select {
+			case <-stopC:
+			case <-dropC:
+				errHandler(errors.New("connection lost"))
+			}
+			close(doneC)
+		}()
+		s.conns <- &userDataStreamTestConn{endpoint: cfg.Endpoint, handler: handler, drop: func() { close(dropC) }}
+		return doneC, stopC, nil
+	}
+}
+
+func (s *userDataStreamTestSuite) TearDownTest() {
+	wsConnect = s.origWsConnect
+	WebsocketReconnectPolicy = nil
+}
+
+func (s *userDataStreamTestSuite) mockResponses(responses ...string) {
+	s.client.Client.do = s.client.do
+	for _, data := range responses {
+		s.client.On("do", anyHTTPRequest()).Return(newHTTPResponse([]byte(data), http.StatusOK), nil).Once()
+	}
+}
+
+func (s *userDataStreamTestSuite) TestListenKeyExpired() {
+	s.mockResponses(`{"listenKey": "key1"}`, `{"listenKey": "key2"}`, `{}`)
+	var mu sync.Mutex
+	var listenKeys []string
+	s.assertReq(func(r *request) {
+		mu.Lock()
+		defer mu.Unlock()
+		listenKeys = append(listenKeys, r.query.Get("listenKey")+r.form.Get("listenKey"))
+	})
+
+	events := make(chan *WsListenKeyExpired, 10)
+	gaps := make(chan *common.UserDataStreamGap, 10)
+	stream := s.client.NewUserDataStream(&userDataStreamTestHandler{events: events}).OnGap(func(gap *common.UserDataStreamGap) {
+		gaps <- gap
+	})
+	doneC, stopC, err := stream.Serve()
+	s.r().NoError(err)
+	conn := <-s.conns
+	s.r().Contains(conn.endpoint, "/ws/key1")
+	s.r().Equal("key1", stream.ListenKey())
+
+	conn.handler([]byte(`{"e":"listenKeyExpired","E":1576653824250,"listenKey":"key1"}`))
+	s.r().Equal("listenKeyExpired", (<-events).EventType)
+	conn = <-s.conns
+	s.r().Contains(conn.endpoint, "/ws/key2")
+	s.r().ErrorIs((<-gaps).Err, common.ErrorListenKeyExpired)
+
+	close(stopC)
+	<-doneC
+	mu.Lock()
+	defer mu.Unlock()
+	s.r().Equal([]string{"", "", "key2"}, listenKeys)
+}
+
+func (s *userDataStreamTestSuite) TestDisconnectedWithReconnectPolicy() {
+	// the stream reconnects by itself with a new listen key, the policy must not hide the gap
+	WebsocketReconnectPolicy = common.NewWsReconnectPolicy()
+	s.mockResponses(`{"listenKey": "key1"}`, `{"listenKey": "key2"}`, `{}`)
+
+	errs := make(chan error, 10)
+	gaps := make(chan *common.UserDataStreamGap, 10)
+	stream := s.client.NewUserDataStream(&userDataStreamTestHandler{events: make(chan *WsListenKeyExpired, 10)}).OnGap(func(gap *common.UserDataStreamGap) {
+		gaps <- gap
+	}).OnError(func(err error) {
+		errs <- err
+	})
+	doneC, stopC, err := stream.Serve()
+	s.r().NoError(err)
+	conn := <-s.conns
+	s.r().Contains(conn.endpoint, "/ws/key1")
+
+	conn.drop()
+	s.r().EqualError(<-errs, "connection lost")
+	conn = <-s.conns
+	s.r().Contains(conn.endpoint, "/ws/key2")
+	select {
+	case gap := <-gaps:
+		s.r().ErrorIs(gap.Err, common.ErrorUserDataStreamDisconnected)
+	case <-time.After(time.Second):
+		s.r().Fail("the gap is not reported")
+	}
+	s.r().Equal("key2", stream.ListenKey())
+
+	close(stopC)
+	<-doneC
+}
+
+type userDataStreamTestHandler struct {
+	testWsUserDataHandler
+	events chan *WsListenKeyExpired
+}
+
+func (h *userDataStreamTestHandler) HandleListenKeyExpired(event *WsListenKeyExpired) {
+	h.events <- event
+}
@@ -28,6 +28,16 @@ func newWsConfig(endpoint string) *WsConfig {
 }
 
 var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	if WebsocketReconnectPolicy != nil {
+		return WebsocketReconnectPolicy.Serve(cfg.Endpoint, func(errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			return wsConnect(cfg, handler, errHandler)
+		}, errHandler)
+	}
+	return wsConnect(cfg, handler, errHandler)
+}
+
+// wsConnect connects to the endpoint without reconnecting, doneC is closed when the connection is lost
+var wsConnect = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	proxy := http.ProxyFromEnvironment
 	if cfg.Proxy != nil {
 		u, err := url.Parse(*cfg.Proxy)
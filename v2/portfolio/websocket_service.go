@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/bitly/go-simplejson"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // Endpoints
@@ -20,6 +22,9 @@ var (
 	WebsocketPongTimeout = time.Second * 10
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = true
+	// WebsocketReconnectPolicy enables the reconnection of the Ws*Serve streams when the connection is lost,
+	// nil by default, see common.NewWsReconnectPolicy
+	WebsocketReconnectPolicy *common.WsReconnectPolicy
 	// WebsocketTimeoutReadWriteConnection is an interval for sending ping/pong messages if WebsocketKeepalive is enabled
 	// using for websocket API (read/write)
 	WebsocketTimeoutReadWriteConnection = time.Second * 10
@@ -492,6 +497,8 @@ func wsUserDataHandler(handler WsUserDataHandler) func(message []byte) {
 	return func(message []byte) {
 		var event struct {
 			EventType string `json:"e"`
+			// EventTime keeps "E" from being decoded into EventType, keys are matched case-insensitively
+			EventTime int64 `json:"E"`
 		}
 		if err := json.Unmarshal(message, &event); err != nil {
 			return
@@ -0,0 +1,112 @@
+package binance
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// NewRateLimiter init a client side rate limiter seeded with the rate limits of ExchangeInfo.
+// Assign it to Client.RateLimiter to enable it.
+func NewRateLimiter(rateLimits []RateLimit) *common.RateLimiter {
+	limits := make([]common.RateLimit, 0, len(rateLimits))
+	for _, rl := range rateLimits {
+		limits = append(limits, common.RateLimit(rl))
+	}
+	return common.NewRateLimiter(limits...)
+}
+
+// requestWeights define the REQUEST_WEIGHT of the /api endpoints, the default weight is 1
+var requestWeights = map[string]int64{
+	http.MethodGet + " /api/v3/exchangeInfo":       20,
+	http.MethodGet + " /api/v3/trades":             25,
+	http.MethodGet + " /api/v1/trades":             25,
+	http.MethodGet + " /api/v3/historicalTrades":   25,
+	http.MethodGet + " /api/v3/aggTrades":          2,
+	http.MethodGet + " /api/v3/klines":             2,
+	http.MethodGet + " /api/v3/uiKlines":           2,
+	http.MethodGet + " /api/v3/avgPrice":           2,
+	http.MethodGet + " /api/v3/order":              4,
+	http.MethodGet + " /api/v3/allOrders":          20,
+	http.MethodGet + " /api/v3/orderList":          4,
+	http.MethodGet + " /api/v3/allOrderList":       20,
+	http.MethodGet + " /api/v3/openOrderList":      6,
+	http.MethodGet + " /api/v3/account":            20,
+	http.MethodGet + " /api/v3/account/commission": 20,
+	http.MethodGet + " /api/v3/myTrades":           20,
+	http.MethodGet + " /api/v3/myAllocations":      20,
+	http.MethodGet + " /api/v3/rateLimit/order":    40,
+	http.MethodPost + " /api/v3/userDataStream":    2,
+	http.MethodPut + " /api/v3/userDataStream":     2,
+	http.MethodDelete + " /api/v3/userDataStream":  2,
+}
+
+// orderEndpoints define the endpoints counting against the ORDERS rate limits
+var orderEndpoints = map[string]int64{
+	http.MethodPost + " /api/v3/order":               1,
+	http.MethodPost + " /api/v3/order/oco":           1,
+	http.MethodPost + " /api/v3/order/cancelReplace": 1,
+	http.MethodPost + " /api/v3/orderList/oto":       2,
+	http.MethodPost + " /api/v3/orderList/otoco":     3,
+	http.MethodPost + " /api/v3/sor/order":           1,
+}
+
+// requestCost return the REQUEST_WEIGHT and ORDERS cost of the request.
+// Only /api endpoints are counted, /sapi endpoints have their own limits.
+func requestCost(r *request) (weight, orders int64) {
+	if !strings.HasPrefix(r.endpoint, "/api/") {
+		return 0, 0
+	}
+	key := r.method + " " + r.endpoint
+	orders = orderEndpoints[key]
+	hasSymbol := r.query.Get("symbol") != "" || r.query.Get("symbols") != ""
+	switch key {
+	case http.MethodGet + " /api/v3/depth":
+		return depthWeight(r.query.Get("limit")), orders
+	case http.MethodGet + " /api/v3/ticker/24hr":
+		if hasSymbol {
+			return 2, orders
+		}
+		return 80, orders
+	case http.MethodGet + " /api/v3/ticker/price", http.MethodGet + " /api/v3/ticker/bookTicker":
+		if hasSymbol {
+			return 2, orders
+		}
+		return 4, orders
+	case http.MethodGet + " /api/v3/ticker", http.MethodGet + " /api/v3/ticker/tradingDay":
+		return 4, orders
+	case http.MethodGet + " /api/v3/openOrders":
+		if hasSymbol {
+			return 6, orders
+		}
+		return 80, orders
+	case http.MethodPost + " /api/v3/order/test", http.MethodPost + " /api/v3/sor/order/test":
+		if r.form.Get("computeCommissionRates") == "true" {
+			return 20, orders
+		}
+		return 1, orders
+	}
+	if w, ok := requestWeights[key]; ok {
+		return w, orders
+	}
+	return 1, orders
+}
+
+func depthWeight(limit string) int64 {
+	l, err := strconv.Atoi(limit)
+	if err != nil || l <= 0 {
+		l = 100
+	}
+	switch {
+	case l <= 100:
+		return 5
+	case l <= 500:
+		return 25
+	case l <= 1000:
+		return 50
+	default:
+		return 250
+	}
+}
@@ -0,0 +1,68 @@
+package binance
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type rateLimiterTestSuite struct {
+	baseTestSuite
+}
+
+func TestRateLimiter(t *testing.T) {
+	suite.Run(t, new(rateLimiterTestSuite))
+}
+
+func (s *rateLimiterTestSuite) TestRequestCost() {
+	tests := []struct {
+		r      *request
+		weight int64
+		orders int64
+	}{
+		{r: &request{method: http.MethodGet, endpoint: "/api/v3/depth", query: map[string][]string{"limit": {"1000"}}}, weight: 50},
+		{r: &request{method: http.MethodGet, endpoint: "/api/v3/depth"}, weight: 5},
+		{r: &request{method: http.MethodGet, endpoint: "/api/v3/ticker/24hr"}, weight: 80},
+		{r: &request{method: http.MethodGet, endpoint: "/api/v3/ticker/24hr", query: map[string][]string{"symbol": {"BTCUSDT"}}}, weight: 2},
+		{r: &request{method: http.MethodPost, endpoint: "/api/v3/order"}, weight: 1, orders: 1},
+		{r: &request{method: http.MethodPost, endpoint: "/api/v3/order/test"}, weight: 1},
+		{r: &request{method: http.MethodPost, endpoint: "/api/v3/order/test", form: map[string][]string{"computeCommissionRates": {"true"}}}, weight: 20},
+		{r: &request{method: http.MethodGet, endpoint: "/api/v3/account"}, weight: 20},
+		{r: &request{method: http.MethodGet, endpoint: "/sapi/v1/margin/account"}, weight: 0},
+	}
+	for _, test := range tests {
+		weight, orders := requestCost(test.r)
+		s.r().Equal(test.weight, weight, test.r.endpoint)
+		s.r().Equal(test.orders, orders, test.r.endpoint)
+	}
+}
+
+func (s *rateLimiterTestSuite) TestCallAPIWithRateLimiter() {
+	s.client.RateLimiter = NewRateLimiter([]RateLimit{
+		{RateLimitType: "REQUEST_WEIGHT", Interval: "MINUTE", IntervalNum: 1, Limit: 25},
+		{RateLimitType: "ORDERS", Interval: "SECOND", IntervalNum: 10, Limit: 100},
+	})
+	s.client.RateLimiter.FailFast = true
+	header := http.Header{}
+	header.Set("X-Mbx-Used-Weight-1m", "20")
+	s.client.Client.do = s.client.do
+	s.client.On("do", anyHTTPRequest()).Return(&http.Response{
+		Body:       io.NopCloser(bytes.NewBufferString(`{"serverTime":1499827319559}`)),
+		StatusCode: http.StatusOK,
+		Header:     header,
+	}, nil).Once()
+
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	s.r().NoError(err)
+	used, _, _ := s.client.RateLimiter.Used(common.RateLimitTypeRequestWeight, "1M")
+	s.r().Equal(int64(20), used)
+
+	_, err = s.client.NewDepthService().Symbol("BTCUSDT").Limit(1000).Do(newContext())
+	s.r().True(common.IsRateLimitError(err))
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
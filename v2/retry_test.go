@@ -0,0 +1,78 @@
+package binance
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type retryTestSuite struct {
+	baseTestSuite
+}
+
+func TestRetry(t *testing.T) {
+	suite.Run(t, new(retryTestSuite))
+}
+
+func (s *retryTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.client.RetryPolicy = common.NewRetryPolicy()
+	s.client.RetryPolicy.MinBackoff = time.Millisecond
+	s.client.RetryPolicy.MaxBackoff = time.Millisecond
+	s.client.Client.do = s.client.do
+}
+
+func (s *retryTestSuite) mockResponse(data string, statusCode int) {
+	s.client.On("do", anyHTTPRequest()).Return(&http.Response{
+		Body:       io.NopCloser(bytes.NewBufferString(data)),
+		StatusCode: statusCode,
+		Header:     http.Header{},
+	}, nil).Once()
+}
+
+func (s *retryTestSuite) TestRetryGet() {
+	s.mockResponse(`{"code":-1003,"msg":"Too many requests."}`, http.StatusTooManyRequests)
+	s.mockResponse(`{"code":-1000,"msg":"An unknown error occurred."}`, http.StatusServiceUnavailable)
+	s.mockResponse(`{"serverTime":1499827319559}`, http.StatusOK)
+
+	serverTime, err := s.client.NewServerTimeService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(1499827319559), serverTime)
+	s.client.AssertNumberOfCalls(s.T(), "do", 3)
+}
+
+func (s *retryTestSuite) TestRetrySignedPost() {
+	var timestamps []string
+	s.assertReq(func(r *request) {
+		timestamps = append(timestamps, r.query.Get(timestampKey))
+		time.Sleep(2 * time.Millisecond)
+	})
+	s.mockResponse(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`, http.StatusBadRequest)
+	s.mockResponse(`{"symbol":"BTCUSDT","orderId":1}`, http.StatusOK)
+
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(int64(1), res.OrderID)
+	s.client.AssertNumberOfCalls(s.T(), "do", 2)
+	s.r().Len(timestamps, 2)
+	s.r().NotEqual(timestamps[0], timestamps[1])
+}
+
+func (s *retryTestSuite) TestNoRetrySignedPost() {
+	s.mockResponse(`{"code":-1007,"msg":"Timeout waiting for response from backend server."}`, http.StatusServiceUnavailable)
+
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").Do(newContext())
+	s.r().Error(err)
+	apiErr, ok := err.(*common.APIError)
+	s.r().True(ok)
+	s.r().Equal(int64(-1007), apiErr.Code)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
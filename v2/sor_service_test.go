@@ -0,0 +1,152 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type sorServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestSORService(t *testing.T) {
+	suite.Run(t, new(sorServiceTestSuite))
+}
+
+func (s *sorServiceTestSuite) TestCreateSOROrder() {
+	data := []byte(`{
+		"symbol": "BTCUSDT",
+		"orderId": 2,
+		"orderListId": -1,
+		"clientOrderId": "sBI1KM6nNtOfj5tccZSKly",
+		"transactTime": 1689149087774,
+		"price": "31000.00000000",
+		"origQty": "0.50000000",
+		"executedQty": "0.50000000",
+		"cummulativeQuoteQty": "14000.00000000",
+		"status": "FILLED",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"side": "BUY",
+		"workingTime": 1689149087774,
+		"fills": [
+			{
+				"matchType": "ONE_PARTY_TRADE_REPORT",
+				"price": "28000.00000000",
+				"qty": "0.50000000",
+				"commission": "0.00000000",
+				"commissionAsset": "BTC",
+				"tradeId": -1,
+				"allocId": 0
+			}
+		],
+		"workingFloor": "SOR",
+		"selfTradePreventionMode": "NONE",
+		"usedSor": true
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":           "BTCUSDT",
+			"side":             SideTypeBuy,
+			"type":             OrderTypeLimit,
+			"timeInForce":      TimeInForceTypeGTC,
+			"quantity":         "0.5",
+			"price":            "31000",
+			"newClientOrderId": "sBI1KM6nNtOfj5tccZSKly",
+			"newOrderRespType": NewOrderRespTypeFULL,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateSOROrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("0.5").Price("31000").
+		NewClientOrderID("sBI1KM6nNtOfj5tccZSKly").NewOrderRespType(NewOrderRespTypeFULL).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("BTCUSDT", res.Symbol)
+	r.Equal(int64(2), res.OrderID)
+	r.Equal(int64(-1), res.OrderListID)
+	r.Equal(OrderStatusTypeFilled, res.Status)
+	r.Equal(int64(1689149087774), res.WorkingTime)
+	r.Equal("SOR", res.WorkingFloor)
+	r.True(res.UsedSor)
+	r.Len(res.Fills, 1)
+	r.Equal(&Fill{
+		TradeID:         -1,
+		Price:           "28000.00000000",
+		Quantity:        "0.50000000",
+		Commission:      "0.00000000",
+		CommissionAsset: "BTC",
+		MatchType:       "ONE_PARTY_TRADE_REPORT",
+		AllocID:         0,
+	}, res.Fills[0])
+
+	err = s.client.NewCreateSOROrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("0.5").Price("31000").
+		NewClientOrderID("sBI1KM6nNtOfj5tccZSKly").NewOrderRespType(NewOrderRespTypeFULL).
+		Test(newContext())
+	r.NoError(err)
+}
+
+func (s *sorServiceTestSuite) TestListAllocations() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"allocationId": 0,
+			"allocationType": "SOR",
+			"orderId": 1,
+			"orderListId": -1,
+			"price": "1.00000000",
+			"qty": "5.00000000",
+			"quoteQty": "5.00000000",
+			"commission": "0.00000000",
+			"commissionAsset": "BTC",
+			"time": 1687506878118,
+			"isBuyer": true,
+			"isMaker": false,
+			"isAllocator": false
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":           "BTCUSDT",
+			"startTime":        int64(1687506878000),
+			"endTime":          int64(1687506879000),
+			"fromAllocationId": int64(0),
+			"limit":            10,
+			"orderId":          int64(1),
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewListAllocationsService().Symbol("BTCUSDT").
+		StartTime(1687506878000).EndTime(1687506879000).FromAllocationID(0).
+		Limit(10).OrderID(1).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal(&Allocation{
+		Symbol:          "BTCUSDT",
+		AllocationID:    0,
+		AllocationType:  "SOR",
+		OrderID:         1,
+		OrderListID:     -1,
+		Price:           "1.00000000",
+		Quantity:        "5.00000000",
+		QuoteQuantity:   "5.00000000",
+		Commission:      "0.00000000",
+		CommissionAsset: "BTC",
+		Time:            1687506878118,
+		IsBuyer:         true,
+		IsMaker:         false,
+		IsAllocator:     false,
+	}, res[0])
+}
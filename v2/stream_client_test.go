@@ -0,0 +1,107 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+)
+
+type streamClientTestSuite struct {
+	suite.Suite
+	origURL string
+}
+
+func TestStreamClient(t *testing.T) {
+	suite.Run(t, new(streamClientTestSuite))
+}
+
+func (s *streamClientTestSuite) SetupTest() {
+	s.origURL = BaseCombinedMainURL
+}
+
+func (s *streamClientTestSuite) TearDownTest() {
+	BaseCombinedMainURL = s.origURL
+}
+
+// startServer starts a server which acknowledges the requests and pushes a message of each subscribed stream
+func (s *streamClientTestSuite) startServer(payload string) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Equal("/stream", r.URL.Path)
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		for {
+			_, message, err := c.ReadMessage()
+			if err != nil {
+				return
+			}
+			req := struct {
+				Params []string `json:"params"`
+				ID     int64    `json:"id"`
+			}{}
+			if err = json.Unmarshal(message, &req); err != nil {
+				return
+			}
+			if err = c.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"result":null,"id":%d}`, req.ID))); err != nil {
+				return
+			}
+			for _, stream := range req.Params {
+				message := fmt.Sprintf(`{"stream":"%s","data":%s}`, stream, payload)
+				if err = c.WriteMessage(websocket.TextMessage, []byte(message)); err != nil {
+					return
+				}
+			}
+		}
+	}))
+	s.T().Cleanup(server.Close)
+	BaseCombinedMainURL = "ws" + strings.TrimPrefix(server.URL, "http") + "/stream?streams="
+}
+
+func (s *streamClientTestSuite) TestSubscribeBookTicker() {
+	s.startServer(`{"u":400900217,"s":"BNBUSDT","b":"25.35190000","B":"31.21000000","a":"25.36520000","A":"40.66000000"}`)
+	c := NewStreamClient()
+	s.Require().NoError(c.Connect())
+	defer c.Close()
+
+	events := make(chan *WsBookTickerEvent, 1)
+	err := c.SubscribeBookTicker(context.Background(), "BNBUSDT", func(event *WsBookTickerEvent) {
+		events <- event
+	})
+	s.Require().NoError(err)
+	s.Equal([]string{"bnbusdt@bookTicker"}, c.Streams())
+	s.Equal(&WsBookTickerEvent{
+		UpdateID:     400900217,
+		Symbol:       "BNBUSDT",
+		BestBidPrice: "25.35190000",
+		BestBidQty:   "31.21000000",
+		BestAskPrice: "25.36520000",
+		BestAskQty:   "40.66000000",
+	}, <-events)
+}
+
+func (s *streamClientTestSuite) TestSubscribeInvalidPayload() {
+	s.startServer(`[]`)
+	c := NewStreamClient()
+	errC := make(chan error, 1)
+	c.ErrHandler = func(err error) {
+		errC <- err
+	}
+	s.Require().NoError(c.Connect())
+	defer c.Close()
+
+	err := c.SubscribeTrade(context.Background(), "BNBUSDT", func(event *WsTradeEvent) {
+		s.Fail("unexpected event")
+	})
+	s.Require().NoError(err)
+	s.Error(<-errC)
+}
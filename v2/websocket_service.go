@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 var (
@@ -24,6 +26,9 @@ var (
 	WebsocketPongTimeout = time.Second * 10
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = true
+	// WebsocketReconnectPolicy enables the reconnection of the Ws*Serve streams when the connection is lost,
+	// nil by default, see common.NewWsReconnectPolicy
+	WebsocketReconnectPolicy *common.WsReconnectPolicy
 	// WebsocketTimeoutReadWriteConnection is an interval for sending ping/pong messages if WebsocketKeepalive is enabled
 	// using for websocket API (read/write)
 	WebsocketTimeoutReadWriteConnection = time.Second * 10
@@ -575,10 +580,11 @@ type WsOrderUpdate struct {
 	UsedSor                    bool   `json:"uS"` // Appears for orders that used SOR
 }
 
+// WsOCOUpdate define order list update, sent for OCO, OTO and OTOCO order lists
 type WsOCOUpdate struct {
 	Symbol          string `json:"s"`
 	OrderListId     int64  `json:"g"`
-	ContingencyType string `json:"c"`
+	ContingencyType string `json:"c"` // OCO, or OTO for both OTO and OTOCO
 	ListStatusType  string `json:"l"`
 	ListOrderStatus string `json:"L"`
 	RejectReason    string `json:"r"`
@@ -587,6 +593,21 @@ type WsOCOUpdate struct {
 	Orders          WsOCOOrderList
 }
 
+// ContingencyTypeValue return the typed ContingencyType
+func (u *WsOCOUpdate) ContingencyTypeValue() ContingencyType {
+	return ContingencyType(u.ContingencyType)
+}
+
+// ListStatusTypeValue return the typed ListStatusType
+func (u *WsOCOUpdate) ListStatusTypeValue() ListStatusType {
+	return ListStatusType(u.ListStatusType)
+}
+
+// ListOrderStatusValue return the typed ListOrderStatus
+func (u *WsOCOUpdate) ListOrderStatusValue() ListOrderStatusType {
+	return ListOrderStatusType(u.ListOrderStatus)
+}
+
 type WsOCOOrderList struct {
 	WsOCOOrders []WsOCOOrder `json:"O"`
 }
@@ -602,6 +623,12 @@ type WsUserDataHandler func(event *WsUserDataEvent)
 
 // WsUserDataServe serve user data handler with listen key
 func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	return wsUserDataServe(wsServe, listenKey, handler, errHandler)
+}
+
+// wsUserDataServe connect to the user data stream using serve, wsServe or wsConnect
+func wsUserDataServe(serve func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error),
+	listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
 	cfg := newWsConfig(endpoint)
 	wsHandler := func(message []byte) {
@@ -644,11 +671,17 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 				errHandler(err)
 				return
 			}
+			// the orders of the list are at the top level of the message
+			err = json.Unmarshal(message, &event.OCOUpdate.Orders)
+			if err != nil {
+				errHandler(err)
+				return
+			}
 		}
 
 		handler(event)
 	}
-	return wsServe(cfg, wsHandler, errHandler)
+	return serve(cfg, wsHandler, errHandler)
 }
 
 // WsMarketStatHandler handle websocket that push single market statistics for 24hr
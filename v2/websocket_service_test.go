@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -815,6 +816,7 @@ func (s *websocketServiceTestSuite) assertUserDataEvent(e, a *WsUserDataEvent) {
 	}
 	s.assertOrderUpdate(&e.OrderUpdate, &a.OrderUpdate)
 	s.assertBalanceUpdate(&e.BalanceUpdate, &a.BalanceUpdate)
+	r.Equal(e.OCOUpdate, a.OCOUpdate, "OCOUpdate")
 }
 
 func (s *websocketServiceTestSuite) testWsUserDataServe(data []byte, expectedEvent *WsUserDataEvent) {
@@ -1133,6 +1135,51 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeOrderUpdateWithTradeOrder
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketServiceTestSuite) TestWsUserDataServeListStatusOTOCO() {
+	data := []byte(`{
+		"e": "listStatus",
+		"E": 1712291372845,
+		"s": "LTCBTC",
+		"g": 1,
+		"c": "OTO",
+		"l": "EXEC_STARTED",
+		"L": "EXECUTING",
+		"r": "NONE",
+		"C": "RumwQpBaDctlUu5jyG5rs0",
+		"T": 1712291372842,
+		"O": [
+			{"s": "LTCBTC", "i": 6, "c": "fM9Y4m23IFJVCQmIrlUmMK"},
+			{"s": "LTCBTC", "i": 7, "c": "6pcQbFIzTXGZQ1e2MkGDq4"},
+			{"s": "LTCBTC", "i": 8, "c": "r4JMv9cwAYYUwwBZfbussx"}
+		]
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event: UserDataEventTypeListStatus,
+		Time:  1712291372845,
+		OCOUpdate: WsOCOUpdate{
+			Symbol:          "LTCBTC",
+			OrderListId:     1,
+			ContingencyType: "OTO",
+			ListStatusType:  "EXEC_STARTED",
+			ListOrderStatus: "EXECUTING",
+			RejectReason:    "NONE",
+			ClientOrderId:   "RumwQpBaDctlUu5jyG5rs0",
+			TransactionTime: 1712291372842,
+			Orders: WsOCOOrderList{
+				WsOCOOrders: []WsOCOOrder{
+					{Symbol: "LTCBTC", OrderId: 6, ClientOrderId: "fM9Y4m23IFJVCQmIrlUmMK"},
+					{Symbol: "LTCBTC", OrderId: 7, ClientOrderId: "6pcQbFIzTXGZQ1e2MkGDq4"},
+					{Symbol: "LTCBTC", OrderId: 8, ClientOrderId: "r4JMv9cwAYYUwwBZfbussx"},
+				},
+			},
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+	s.Equal(ContingencyTypeOTO, expectedEvent.OCOUpdate.ContingencyTypeValue())
+	s.Equal(ListStatusTypeExecStarted, expectedEvent.OCOUpdate.ListStatusTypeValue())
+	s.Equal(ListOrderStatusTypeExecuting, expectedEvent.OCOUpdate.ListOrderStatusValue())
+}
+
 func (s *websocketServiceTestSuite) TestWsMarketStatServe() {
 	data := []byte(`{
   		"e": "24hrTicker",
@@ -1635,6 +1682,37 @@ func (s *websocketServiceTestSuite) TestBookTickerServe() {
 	<-doneC
 }
 
+func (s *websocketServiceTestSuite) TestBookTickerStream() {
+	data := []byte(`{
+  		"u":17242169,
+  		"s":"BTCUSD_200626",
+  		"b":"9548.1",
+  		"B":"52",
+  		"a":"9548.5",
+  		"A":"11"
+	  }`)
+	fakeErrMsg := "fake error"
+	s.mockWsServe(data, errors.New(fakeErrMsg))
+	defer s.assertWsServe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	eventC, errC, err := WsBookTickerStream(ctx, "BTCUSD_200626", nil)
+	s.r().NoError(err)
+	s.assertWsBookTickerEvent(&WsBookTickerEvent{
+		UpdateID:     17242169,
+		Symbol:       "BTCUSD_200626",
+		BestBidPrice: "9548.1",
+		BestBidQty:   "52",
+		BestAskPrice: "9548.5",
+		BestAskQty:   "11",
+	}, <-eventC)
+	s.r().EqualError(<-errC, fakeErrMsg)
+
+	cancel()
+	_, ok := <-eventC
+	s.r().False(ok)
+}
+
 // https://binance-docs.github.io/apidocs/spot/en/#all-book-tickers-stream
 func (s *websocketServiceTestSuite) TestAllBookTickerServe() {
 	data := []byte(`{
@@ -0,0 +1,98 @@
+package binance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type websocketTestSuite struct {
+	suite.Suite
+	origPolicy *common.WsReconnectPolicy
+}
+
+func TestWebsocket(t *testing.T) {
+	suite.Run(t, new(websocketTestSuite))
+}
+
+func (s *websocketTestSuite) SetupTest() {
+	s.origPolicy = WebsocketReconnectPolicy
+}
+
+func (s *websocketTestSuite) TearDownTest() {
+	WebsocketReconnectPolicy = s.origPolicy
+}
+
+// startDroppingServer starts a server which sends the number of the connection and closes the first connection
+func (s *websocketTestSuite) startDroppingServer() string {
+	upgrader := websocket.Upgrader{}
+	var conns int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		n := atomic.AddInt64(&conns, 1)
+		if err := c.WriteMessage(websocket.TextMessage, []byte{byte('0' + n)}); err != nil || n == 1 {
+			return
+		}
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	s.T().Cleanup(server.Close)
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func (s *websocketTestSuite) TestServeReconnect() {
+	endpoint := s.startDroppingServer()
+	states := make(chan common.WsState, 10)
+	policy := common.NewWsReconnectPolicy()
+	policy.MinBackoff = time.Millisecond
+	policy.StateHandler = func(event *common.WsStateEvent) {
+		s.Equal(endpoint, event.Endpoint)
+		states <- event.State
+	}
+	WebsocketReconnectPolicy = policy
+
+	messages := make(chan string, 10)
+	doneC, stopC, err := wsServe(newWsConfig(endpoint), func(message []byte) {
+		messages <- string(message)
+	}, func(err error) {})
+	s.Require().NoError(err)
+
+	s.Equal("1", <-messages)
+	s.Equal("2", <-messages)
+	s.Equal(common.WsStateConnected, <-states)
+	s.Equal(common.WsStateDisconnected, <-states)
+	s.Equal(common.WsStateReconnecting, <-states)
+	s.Equal(common.WsStateConnected, <-states)
+
+	close(stopC)
+	<-doneC
+}
+
+func (s *websocketTestSuite) TestServeWithoutReconnect() {
+	endpoint := s.startDroppingServer()
+	WebsocketReconnectPolicy = nil
+
+	messages := make(chan string, 10)
+	doneC, _, err := wsServe(newWsConfig(endpoint), func(message []byte) {
+		messages <- string(message)
+	}, func(err error) {})
+	s.Require().NoError(err)
+	<-doneC
+	s.Equal("1", <-messages)
+	s.Len(messages, 0)
+}
@@ -0,0 +1,259 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/common/websocket"
+)
+
+// WsApiClient define websocket API client.
+// All services created by the client share a single connection, responses are matched to requests by request id.
+type WsApiClient struct {
+	c          websocket.Client
+	session    *websocket.Session
+	ApiKey     string
+	SecretKey  string
+	KeyType    string
+	TimeOffset int64
+}
+
+// NewWsApiClient init WsApiClient
+func NewWsApiClient(apiKey, secretKey string) (*WsApiClient, error) {
+	conn, err := websocket.NewConnection(WsApiInitReadWriteConn, WebsocketKeepalive, WebsocketTimeoutReadWriteConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := websocket.NewClient(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WsApiClient{
+		c:         client,
+		session:   websocket.NewSession(client),
+		ApiKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   common.KeyTypeHmac,
+	}, nil
+}
+
+// NewWsApiClient init WsApiClient with the keys of the client.
+// The logger and UsedLimits of the client are shared, the rateLimits of the websocket API responses update c.UsedLimits.
+func (c *Client) NewWsApiClient() (*WsApiClient, error) {
+	client, err := NewWsApiClient(c.APIKey, c.SecretKey)
+	if err != nil {
+		return nil, err
+	}
+	client.KeyType = c.KeyType
+	client.TimeOffset = c.TimeOffset
+	client.SetLogger(c.logger())
+	client.SetUsedLimits(c.UsedLimits)
+	return client, nil
+}
+
+// wsApiResponse define the envelope of a websocket API response with a raw result
+type wsApiResponse struct {
+	Id     string          `json:"id"`
+	Status int             `json:"status"`
+	Result json.RawMessage `json:"result"`
+
+	// error response
+	Error *common.APIError `json:"error,omitempty"`
+}
+
+// createRequest creates a request, signed requests get api key, timestamp and signature unless the session is logged on
+func (c *WsApiClient) createRequest(requestID string, method websocket.WsApiMethodType, p params, signed bool) ([]byte, error) {
+	if !signed {
+		return websocket.CreateUnsignedRequest(requestID, method, p)
+	}
+	return c.session.CreateRequest(c.requestData(requestID), method, p)
+}
+
+func (c *WsApiClient) requestData(requestID string) websocket.RequestData {
+	return websocket.NewRequestData(
+		requestID,
+		c.ApiKey,
+		c.SecretKey,
+		c.TimeOffset,
+		c.KeyType,
+	)
+}
+
+// SessionLogon authenticates the connection with the api key of the client, KeyType must be ED25519.
+// Signed requests are then sent without signature, the session is logged on again after a reconnect.
+func (c *WsApiClient) SessionLogon(requestID string) (*websocket.SessionResponse, error) {
+	return c.session.Logon(c.requestData(requestID))
+}
+
+// SessionStatus returns the authentication status of the connection
+func (c *WsApiClient) SessionStatus(requestID string) (*websocket.SessionResponse, error) {
+	return c.session.Status(requestID)
+}
+
+// SessionLogout forgets the api key of the connection, signed requests are signed again
+func (c *WsApiClient) SessionLogout(requestID string) (*websocket.SessionResponse, error) {
+	return c.session.Logout(requestID)
+}
+
+// SetSessionErrHandler sets the handler called when the session can not be logged on again after a reconnect
+func (c *WsApiClient) SetSessionErrHandler(errHandler ErrHandler) {
+	c.session.ErrHandler = errHandler
+}
+
+// write sends a request, the response is delivered into the read channel
+func (c *WsApiClient) write(requestID string, method websocket.WsApiMethodType, p params, signed bool) error {
+	rawData, err := c.createRequest(requestID, method, p, signed)
+	if err != nil {
+		return err
+	}
+
+	return c.c.Write(requestID, rawData)
+}
+
+// wsApiWriteSync sends a request and unmarshals the response with the same request id into response
+type wsApiWriteSync func(requestID string, method websocket.WsApiMethodType, p params, signed bool, response interface{}) error
+
+// writeSync sends a request and unmarshals the response with the same request id into response,
+// ErrorWsReadConnectionTimeout is returned if it is not received within WriteSyncWsTimeout
+func (c *WsApiClient) writeSync(requestID string, method websocket.WsApiMethodType, p params, signed bool, response interface{}) error {
+	return c.send(requestID, method, p, signed, response, func(rawData []byte) ([]byte, error) {
+		return c.c.WriteSync(requestID, rawData, websocket.WriteSyncWsTimeout)
+	})
+}
+
+// writeSyncContext returns a wsApiWriteSync waiting for the response until ctx is done
+func (c *WsApiClient) writeSyncContext(ctx context.Context) wsApiWriteSync {
+	return func(requestID string, method websocket.WsApiMethodType, p params, signed bool, response interface{}) error {
+		return c.send(requestID, method, p, signed, response, func(rawData []byte) ([]byte, error) {
+			return websocket.WriteSyncContext(ctx, c.c, requestID, rawData)
+		})
+	}
+}
+
+// send creates a request, writes it with writeSync and unmarshals the response into response
+func (c *WsApiClient) send(requestID string, method websocket.WsApiMethodType, p params, signed bool, response interface{}, writeSync func(rawData []byte) ([]byte, error)) error {
+	rawData, err := c.createRequest(requestID, method, p, signed)
+	if err != nil {
+		return err
+	}
+
+	data, err := writeSync(rawData)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, response)
+}
+
+// ReceiveAllDataBeforeStop waits until all responses will be received from websocket until timeout expired
+func (c *WsApiClient) ReceiveAllDataBeforeStop(timeout time.Duration) {
+	c.c.Wait(timeout)
+}
+
+// GetReadChannel returns channel with API response data (including API errors)
+func (c *WsApiClient) GetReadChannel() <-chan []byte {
+	return c.c.GetReadChannel()
+}
+
+// GetReadErrorChannel returns channel with errors which are occurred while reading websocket connection
+func (c *WsApiClient) GetReadErrorChannel() <-chan error {
+	return c.c.GetReadErrorChannel()
+}
+
+// GetReconnectCount returns count of reconnect attempts by client
+func (c *WsApiClient) GetReconnectCount() int64 {
+	return c.c.GetReconnectCount()
+}
+
+// SetLogger sets the logger of the connection, the api keys and signatures of the requests are redacted.
+// It is a no-op if the connection does not implement websocket.ConfigurableClient.
+func (c *WsApiClient) SetLogger(logger common.Logger) {
+	if cc, ok := c.c.(websocket.ConfigurableClient); ok {
+		cc.SetLogger(logger)
+	}
+}
+
+// SetUsedLimits sets the UsedLimits updated from the rateLimits of the responses,
+// e.g. the UsedLimits of the REST client to track the usage of both, see Client.NewWsApiClient.
+// It is a no-op if the connection does not implement websocket.ConfigurableClient.
+func (c *WsApiClient) SetUsedLimits(usedLimits *common.UsedLimits) {
+	if cc, ok := c.c.(websocket.ConfigurableClient); ok {
+		cc.SetUsedLimits(usedLimits)
+	}
+}
+
+// NewOrderCreateWsService init OrderCreateWsService using the client connection
+func (c *WsApiClient) NewOrderCreateWsService() *OrderCreateWsService {
+	return &OrderCreateWsService{
+		c:          c.c,
+		session:    c.session,
+		ApiKey:     c.ApiKey,
+		SecretKey:  c.SecretKey,
+		KeyType:    c.KeyType,
+		TimeOffset: c.TimeOffset,
+	}
+}
+
+// NewOrderCancelWsService init OrderCancelWsService
+func (c *WsApiClient) NewOrderCancelWsService() *OrderCancelWsService {
+	return &OrderCancelWsService{c: c}
+}
+
+// NewOrderStatusWsService init OrderStatusWsService
+func (c *WsApiClient) NewOrderStatusWsService() *OrderStatusWsService {
+	return &OrderStatusWsService{c: c}
+}
+
+// NewOrderCancelReplaceWsService init OrderCancelReplaceWsService
+func (c *WsApiClient) NewOrderCancelReplaceWsService() *OrderCancelReplaceWsService {
+	return &OrderCancelReplaceWsService{c: c}
+}
+
+// NewOpenOrdersStatusWsService init OpenOrdersStatusWsService
+func (c *WsApiClient) NewOpenOrdersStatusWsService() *OpenOrdersStatusWsService {
+	return &OpenOrdersStatusWsService{c: c}
+}
+
+// NewOpenOrdersCancelAllWsService init OpenOrdersCancelAllWsService
+func (c *WsApiClient) NewOpenOrdersCancelAllWsService() *OpenOrdersCancelAllWsService {
+	return &OpenOrdersCancelAllWsService{c: c}
+}
+
+// NewOrderListPlaceOCOWsService init OrderListPlaceOCOWsService
+func (c *WsApiClient) NewOrderListPlaceOCOWsService() *OrderListPlaceOCOWsService {
+	return &OrderListPlaceOCOWsService{c: c}
+}
+
+// NewAccountStatusWsService init AccountStatusWsService
+func (c *WsApiClient) NewAccountStatusWsService() *AccountStatusWsService {
+	return &AccountStatusWsService{c: c}
+}
+
+// NewMyTradesWsService init MyTradesWsService
+func (c *WsApiClient) NewMyTradesWsService() *MyTradesWsService {
+	return &MyTradesWsService{c: c}
+}
+
+// NewDepthWsService init DepthWsService
+func (c *WsApiClient) NewDepthWsService() *DepthWsService {
+	return &DepthWsService{c: c}
+}
+
+// NewKlinesWsService init KlinesWsService
+func (c *WsApiClient) NewKlinesWsService() *KlinesWsService {
+	return &KlinesWsService{c: c}
+}
+
+// NewTickerPriceWsService init TickerPriceWsService
+func (c *WsApiClient) NewTickerPriceWsService() *TickerPriceWsService {
+	return &TickerPriceWsService{c: c}
+}
+
+// NewExchangeInfoWsService init ExchangeInfoWsService
+func (c *WsApiClient) NewExchangeInfoWsService() *ExchangeInfoWsService {
+	return &ExchangeInfoWsService{c: c}
+}